@@ -0,0 +1,357 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sap/go-generics/heap"
+	"github.com/sap/go-generics/maps"
+)
+
+// Source of the current time; can be injected into caches (see Options) to make expiry deterministic in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// Clock returning the actual system time.
+type SystemClock struct{}
+
+// Get current time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Clock which is advanced manually; intended to be used in tests.
+// The zero value starts at the zero time. Manual clocks are safe for concurrent use.
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// Create new manual clock, starting at the given time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Get current time.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance clock by given duration.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Reason why an entry was removed from a cache.
+type EvictionReason int
+
+const (
+	// Entry was evicted because a size or cost bound was exceeded.
+	EvictionReasonCapacity EvictionReason = iota
+	// Entry was removed because it expired.
+	EvictionReasonExpired
+)
+
+// Cache options.
+type Options[K comparable, V any] struct {
+	// Maximum number of entries; zero means unbounded.
+	MaxEntries int
+	// Maximum total cost of all entries; zero means unbounded.
+	MaxCost int64
+	// Function calculating the cost of an entry; if nil, each entry has cost 1.
+	Cost func(K, V) int64
+	// Time to live of entries, counted from the time they were set; zero means that entries do not expire.
+	TTL time.Duration
+	// Callback invoked when entries are evicted (due to bounds) or expire; not invoked for entries removed by Delete() or Clear().
+	// The callback is invoked after the cache lock was released, so it may access the cache.
+	OnEvict func(K, V, EvictionReason)
+	// Clock used to determine expiry; if nil, the system clock is used.
+	Clock Clock
+}
+
+// Cache statistics.
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// Get ratio of hits to lookups; returns zero if there were no lookups.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	cost    int64
+	expires time.Time
+	// policy specific data
+	freq   uint64
+	tick   uint64
+	handle *heap.Handle[*entry[K, V]]
+}
+
+// Eviction policy; determines which entry is evicted next.
+type policy[K comparable, V any] interface {
+	// Register a new entry.
+	add(e *entry[K, V])
+	// Record access to an entry.
+	access(e *entry[K, V])
+	// Unregister an entry.
+	remove(e *entry[K, V])
+	// Get the entry to be evicted next; returns nil if there are no entries.
+	victim() *entry[K, V]
+	// Report whether the victims are always ordered by expiry.
+	expiryOrdered() bool
+}
+
+type eviction[K comparable, V any] struct {
+	e      *entry[K, V]
+	reason EvictionReason
+}
+
+// Common cache implementation; safe for concurrent use.
+type cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries map[K]*entry[K, V]
+	policy  policy[K, V]
+	options Options[K, V]
+	cost    int64
+	stats   Stats
+}
+
+func newCache[K comparable, V any](p policy[K, V], options Options[K, V]) cache[K, V] {
+	if options.Cost == nil {
+		options.Cost = func(K, V) int64 { return 1 }
+	}
+	if options.Clock == nil {
+		options.Clock = SystemClock{}
+	}
+	return cache[K, V]{entries: make(map[K]*entry[K, V]), policy: p, options: options}
+}
+
+// Get value for given key; the second return value reports whether the key exists (and is not expired).
+// Updates statistics, and records the access for the eviction policy.
+func (c *cache[K, V]) Get(k K) (V, bool) {
+	var evicted []eviction[K, V]
+	defer func() { c.notify(evicted) }()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if ok && c.expired(e, c.options.Clock.Now()) {
+		evicted = append(evicted, c.evict(e, EvictionReasonExpired))
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		var v V
+		return v, false
+	}
+	c.stats.Hits++
+	c.policy.access(e)
+	return e.value, true
+}
+
+// Get value for given key, without updating statistics or recording the access.
+func (c *cache[K, V]) Peek(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok || c.expired(e, c.options.Clock.Now()) {
+		var v V
+		return v, false
+	}
+	return e.value, true
+}
+
+// Set value for given key.
+// Entries are evicted as needed, in order to satisfy the configured bounds; if the cost of a new entry
+// exceeds the maximum cost, it will be rejected (and reported as evicted), without evicting other entries.
+// If the key already exists in that case, the existing entry will be removed (and reported as evicted) instead.
+func (c *cache[K, V]) Set(k K, v V) {
+	var evicted []eviction[K, V]
+	defer func() { c.notify(evicted) }()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.options.Clock.Now()
+	if c.policy.expiryOrdered() {
+		for x := c.policy.victim(); x != nil && c.expired(x, now); x = c.policy.victim() {
+			evicted = append(evicted, c.evict(x, EvictionReasonExpired))
+		}
+	}
+	cost := c.options.Cost(k, v)
+	rejected := c.options.MaxCost > 0 && cost > c.options.MaxCost
+	e, ok := c.entries[k]
+	if ok {
+		if rejected {
+			// entry will never fit, so drop it right away, instead of evicting other entries
+			evicted = append(evicted, c.evict(e, EvictionReasonCapacity))
+			return
+		}
+		c.cost -= e.cost
+		e.value = v
+		e.cost = cost
+		c.cost += e.cost
+		if c.options.TTL > 0 {
+			// re-register entry, so that expiry-ordered policies keep their order
+			c.policy.remove(e)
+			e.expires = now.Add(c.options.TTL)
+			c.policy.add(e)
+		}
+		c.policy.access(e)
+		for c.exceeds(0, 0) {
+			evicted = append(evicted, c.evict(c.policy.victim(), EvictionReasonCapacity))
+		}
+		return
+	}
+	e = &entry[K, V]{key: k, value: v, cost: cost}
+	if c.options.TTL > 0 {
+		e.expires = now.Add(c.options.TTL)
+	}
+	if rejected {
+		// entry will never fit, so reject it right away, instead of evicting other entries
+		c.stats.Evictions++
+		evicted = append(evicted, eviction[K, V]{e: e, reason: EvictionReasonCapacity})
+		return
+	}
+	// make room before adding the entry, such that the new entry is not considered as victim
+	for len(c.entries) > 0 && c.exceeds(1, e.cost) {
+		evicted = append(evicted, c.evict(c.policy.victim(), EvictionReasonCapacity))
+	}
+	c.entries[k] = e
+	c.cost += e.cost
+	c.policy.add(e)
+}
+
+// Delete entry for given key; reports whether the key existed (and was not expired).
+func (c *cache[K, V]) Delete(k K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok {
+		return false
+	}
+	c.remove(e)
+	return !c.expired(e, c.options.Clock.Now())
+}
+
+// Remove all expired entries.
+func (c *cache[K, V]) Purge() {
+	var evicted []eviction[K, V]
+	defer func() { c.notify(evicted) }()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.options.Clock.Now()
+	for _, e := range c.entries {
+		if c.expired(e, now) {
+			evicted = append(evicted, c.evict(e, EvictionReasonExpired))
+		}
+	}
+}
+
+// Remove all entries; statistics are retained.
+func (c *cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		c.remove(e)
+	}
+}
+
+// Get number of entries (including expired entries which were not yet removed).
+func (c *cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Get total cost of all entries (including expired entries which were not yet removed).
+func (c *cache[K, V]) Cost() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cost
+}
+
+// Get statistics.
+func (c *cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Get snapshot of the (non-expired) entries as map.
+// Will return an empty non-nil map in case the cache is empty.
+func (c *cache[K, V]) Snapshot() map[K]V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.options.Clock.Now()
+	m := make(map[K]V, len(c.entries))
+	for k, e := range c.entries {
+		if !c.expired(e, now) {
+			m[k] = e.value
+		}
+	}
+	return m
+}
+
+// Get snapshot of the (non-expired) keys; order is not predictable.
+// Will return an empty non-nil slice in case the cache is empty.
+func (c *cache[K, V]) Keys() []K {
+	return maps.Keys(c.Snapshot())
+}
+
+// Get snapshot of the (non-expired) values; order is not predictable.
+// Will return an empty non-nil slice in case the cache is empty.
+func (c *cache[K, V]) Values() []V {
+	return maps.Values(c.Snapshot())
+}
+
+func (c *cache[K, V]) expired(e *entry[K, V], now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// Report whether the bounds would be exceeded after adding n entries with the given total cost.
+func (c *cache[K, V]) exceeds(n int, cost int64) bool {
+	return (c.options.MaxEntries > 0 && len(c.entries)+n > c.options.MaxEntries) ||
+		(c.options.MaxCost > 0 && c.cost+cost > c.options.MaxCost)
+}
+
+func (c *cache[K, V]) remove(e *entry[K, V]) {
+	delete(c.entries, e.key)
+	c.policy.remove(e)
+	c.cost -= e.cost
+}
+
+func (c *cache[K, V]) evict(e *entry[K, V], reason EvictionReason) eviction[K, V] {
+	c.remove(e)
+	if reason == EvictionReasonExpired {
+		c.stats.Expirations++
+	} else {
+		c.stats.Evictions++
+	}
+	return eviction[K, V]{e: e, reason: reason}
+}
+
+func (c *cache[K, V]) notify(evicted []eviction[K, V]) {
+	if c.options.OnEvict == nil {
+		return
+	}
+	for _, x := range evicted {
+		c.options.OnEvict(x.e.key, x.e.value, x.reason)
+	}
+}
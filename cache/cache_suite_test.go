@@ -0,0 +1,202 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/cache"
+)
+
+func TestCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cache Suite")
+}
+
+type evictionRecord struct {
+	key    string
+	value  int
+	reason cache.EvictionReason
+}
+
+var _ = Describe("cache", func() {
+	var clock *cache.ManualClock
+	var evictions []evictionRecord
+	var onEvict func(string, int, cache.EvictionReason)
+
+	BeforeEach(func() {
+		clock = cache.NewManualClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		evictions = nil
+		onEvict = func(k string, v int, reason cache.EvictionReason) {
+			evictions = append(evictions, evictionRecord{key: k, value: v, reason: reason})
+		}
+	})
+
+	Describe("tests for LRU", func() {
+		It("should evict the least recently used entries", func() {
+			c := cache.NewLRU(cache.Options[string, int]{MaxEntries: 2, OnEvict: onEvict})
+			c.Set("a", 1)
+			c.Set("b", 2)
+			_, ok := c.Get("a")
+			Expect(ok).To(BeTrue())
+			c.Set("c", 3)
+			Expect(c.Keys()).To(ConsistOf("a", "c"))
+			Expect(evictions).To(Equal([]evictionRecord{{"b", 2, cache.EvictionReasonCapacity}}))
+			_, ok = c.Get("b")
+			Expect(ok).To(BeFalse())
+			Expect(c.Stats()).To(Equal(cache.Stats{Hits: 1, Misses: 1, Evictions: 1}))
+			Expect(c.Stats().HitRatio()).To(Equal(0.5))
+		})
+		It("should not record access on Peek()", func() {
+			c := cache.NewLRU(cache.Options[string, int]{MaxEntries: 2})
+			c.Set("a", 1)
+			c.Set("b", 2)
+			v, ok := c.Peek("a")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(1))
+			c.Set("c", 3)
+			Expect(c.Keys()).To(ConsistOf("b", "c"))
+			Expect(c.Stats()).To(Equal(cache.Stats{Evictions: 1}))
+		})
+		It("should respect cost bounds", func() {
+			c := cache.NewLRU(cache.Options[string, int]{MaxCost: 10, Cost: func(_ string, v int) int64 { return int64(v) }, OnEvict: onEvict})
+			c.Set("a", 4)
+			c.Set("b", 4)
+			c.Set("c", 4)
+			Expect(c.Snapshot()).To(Equal(map[string]int{"b": 4, "c": 4}))
+			Expect(c.Cost()).To(Equal(int64(8)))
+			c.Set("b", 1)
+			Expect(c.Cost()).To(Equal(int64(5)))
+			c.Set("d", 11)
+			Expect(c.Snapshot()).To(Equal(map[string]int{"b": 1, "c": 4}))
+			Expect(evictions).To(Equal([]evictionRecord{{"a", 4, cache.EvictionReasonCapacity}, {"d", 11, cache.EvictionReasonCapacity}}))
+		})
+		It("should drop an updated entry exceeding the maximum cost, without evicting other entries", func() {
+			c := cache.NewLRU(cache.Options[string, int]{MaxCost: 10, Cost: func(_ string, v int) int64 { return int64(v) }, OnEvict: onEvict})
+			c.Set("a", 3)
+			c.Set("b", 3)
+			c.Set("a", 20)
+			Expect(c.Snapshot()).To(Equal(map[string]int{"b": 3}))
+			Expect(c.Cost()).To(Equal(int64(3)))
+			Expect(evictions).To(Equal([]evictionRecord{{"a", 3, cache.EvictionReasonCapacity}}))
+			Expect(c.Stats()).To(Equal(cache.Stats{Evictions: 1}))
+		})
+		It("should delete and clear entries", func() {
+			c := cache.NewLRU(cache.Options[string, int]{OnEvict: onEvict})
+			c.Set("a", 1)
+			c.Set("b", 2)
+			Expect(c.Delete("a")).To(BeTrue())
+			Expect(c.Delete("a")).To(BeFalse())
+			Expect(c.Len()).To(Equal(1))
+			c.Clear()
+			Expect(c.Len()).To(Equal(0))
+			Expect(c.Cost()).To(Equal(int64(0)))
+			Expect(c.Keys()).To(Equal([]string{}))
+			Expect(c.Values()).To(Equal([]int{}))
+			Expect(evictions).To(BeEmpty())
+		})
+		It("should expire entries if a time to live is given", func() {
+			c := cache.NewLRU(cache.Options[string, int]{TTL: time.Minute, Clock: clock, OnEvict: onEvict})
+			c.Set("a", 1)
+			clock.Advance(30 * time.Second)
+			c.Set("b", 2)
+			clock.Advance(30 * time.Second)
+			Expect(c.Snapshot()).To(Equal(map[string]int{"b": 2}))
+			_, ok := c.Get("a")
+			Expect(ok).To(BeFalse())
+			Expect(c.Len()).To(Equal(1))
+			Expect(evictions).To(Equal([]evictionRecord{{"a", 1, cache.EvictionReasonExpired}}))
+			Expect(c.Stats()).To(Equal(cache.Stats{Misses: 1, Expirations: 1}))
+		})
+	})
+
+	Describe("tests for LFU", func() {
+		It("should evict the least frequently used entries", func() {
+			c := cache.NewLFU(cache.Options[string, int]{MaxEntries: 2, OnEvict: onEvict})
+			c.Set("a", 1)
+			c.Set("b", 2)
+			c.Get("a")
+			c.Get("a")
+			c.Get("b")
+			c.Set("c", 3)
+			Expect(c.Keys()).To(ConsistOf("a", "c"))
+			c.Set("d", 4)
+			Expect(c.Keys()).To(ConsistOf("a", "d"))
+			Expect(evictions).To(Equal([]evictionRecord{{"b", 2, cache.EvictionReasonCapacity}, {"c", 3, cache.EvictionReasonCapacity}}))
+		})
+		It("should break ties by recency", func() {
+			c := cache.NewLFU(cache.Options[string, int]{MaxEntries: 2})
+			c.Set("a", 1)
+			c.Set("b", 2)
+			c.Get("b")
+			c.Get("a")
+			c.Set("c", 3)
+			Expect(c.Keys()).To(ConsistOf("a", "c"))
+		})
+	})
+
+	Describe("tests for TTL", func() {
+		It("should panic for non-positive time to live", func() {
+			Expect(func() { cache.NewTTL(0, cache.Options[string, int]{}) }).To(Panic())
+		})
+		It("should expire entries", func() {
+			c := cache.NewTTL(time.Minute, cache.Options[string, int]{Clock: clock, OnEvict: onEvict})
+			c.Set("a", 1)
+			clock.Advance(40 * time.Second)
+			c.Set("b", 2)
+			v, ok := c.Get("a")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(1))
+			clock.Advance(20 * time.Second)
+			_, ok = c.Peek("a")
+			Expect(ok).To(BeFalse())
+			c.Set("c", 3)
+			Expect(c.Len()).To(Equal(2))
+			Expect(evictions).To(Equal([]evictionRecord{{"a", 1, cache.EvictionReasonExpired}}))
+			clock.Advance(time.Minute)
+			c.Purge()
+			Expect(c.Len()).To(Equal(0))
+			Expect(c.Stats().Expirations).To(Equal(uint64(3)))
+		})
+		It("should renew the expiry when setting an existing entry", func() {
+			c := cache.NewTTL(time.Minute, cache.Options[string, int]{Clock: clock, MaxEntries: 2})
+			c.Set("a", 1)
+			c.Set("b", 2)
+			clock.Advance(30 * time.Second)
+			c.Set("a", 3)
+			c.Set("c", 4)
+			Expect(c.Snapshot()).To(Equal(map[string]int{"a": 3, "c": 4}))
+			clock.Advance(45 * time.Second)
+			Expect(c.Snapshot()).To(Equal(map[string]int{"a": 3, "c": 4}))
+			clock.Advance(15 * time.Second)
+			Expect(c.Snapshot()).To(BeEmpty())
+		})
+	})
+
+	Describe("tests for concurrent use", func() {
+		It("should be safe for concurrent use", func() {
+			c := cache.NewLRU(cache.Options[int, int]{MaxEntries: 50})
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 1000; j++ {
+						c.Set(j%100, j)
+						c.Get((j + i) % 100)
+					}
+				}()
+			}
+			wg.Wait()
+			Expect(c.Len()).To(Equal(50))
+			Expect(c.Stats().Hits + c.Stats().Misses).To(Equal(uint64(8000)))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"github.com/sap/go-generics/heap"
+)
+
+// Cache evicting the least frequently used entries; ties are broken by evicting the least recently used entry.
+// Safe for concurrent use.
+type LFU[K comparable, V any] struct {
+	cache[K, V]
+}
+
+// Create new LFU cache.
+func NewLFU[K comparable, V any](options Options[K, V]) *LFU[K, V] {
+	f := func(x, y *entry[K, V]) bool {
+		return x.freq > y.freq || (x.freq == y.freq && x.tick > y.tick)
+	}
+	return &LFU[K, V]{cache: newCache(&lfuPolicy[K, V]{h: heap.NewBy(f)}, options)}
+}
+
+// Access frequency order of entries (least frequently used first).
+type lfuPolicy[K comparable, V any] struct {
+	h    *heap.Heap[*entry[K, V]]
+	tick uint64
+}
+
+func (p *lfuPolicy[K, V]) add(e *entry[K, V]) {
+	p.tick++
+	e.tick = p.tick
+	e.handle = p.h.Push(e)
+}
+
+func (p *lfuPolicy[K, V]) access(e *entry[K, V]) {
+	p.tick++
+	e.freq++
+	e.tick = p.tick
+	p.h.Fix(e.handle)
+}
+
+func (p *lfuPolicy[K, V]) remove(e *entry[K, V]) {
+	p.h.Remove(e.handle)
+	e.handle = nil
+}
+
+func (p *lfuPolicy[K, V]) victim() *entry[K, V] {
+	e, _ := p.h.Peek()
+	return e
+}
+
+func (p *lfuPolicy[K, V]) expiryOrdered() bool {
+	return false
+}
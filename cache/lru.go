@@ -0,0 +1,49 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"github.com/sap/go-generics/maps"
+)
+
+// Cache evicting the least recently used entries.
+// Safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	cache[K, V]
+}
+
+// Create new LRU cache.
+func NewLRU[K comparable, V any](options Options[K, V]) *LRU[K, V] {
+	return &LRU[K, V]{cache: newCache(&lruPolicy[K, V]{}, options)}
+}
+
+// Recency order of entries (least recently used first).
+type lruPolicy[K comparable, V any] struct {
+	m maps.OrderedMap[K, *entry[K, V]]
+}
+
+func (p *lruPolicy[K, V]) add(e *entry[K, V]) {
+	p.m.Set(e.key, e)
+}
+
+func (p *lruPolicy[K, V]) access(e *entry[K, V]) {
+	p.m.MoveToBack(e.key)
+}
+
+func (p *lruPolicy[K, V]) remove(e *entry[K, V]) {
+	p.m.Delete(e.key)
+}
+
+func (p *lruPolicy[K, V]) victim() *entry[K, V] {
+	for _, e := range p.m.All() {
+		return e
+	}
+	return nil
+}
+
+func (p *lruPolicy[K, V]) expiryOrdered() bool {
+	return false
+}
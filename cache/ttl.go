@@ -0,0 +1,56 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"time"
+
+	"github.com/sap/go-generics/maps"
+)
+
+// Cache expiring entries after a fixed time to live; if bounds are exceeded, the entries closest to expiry are evicted.
+// Expired entries are removed lazily (when accessed, or when setting entries), or explicitly by Purge().
+// Safe for concurrent use.
+type TTL[K comparable, V any] struct {
+	cache[K, V]
+}
+
+// Create new TTL cache, with the given time to live (overriding options.TTL).
+// Panics if the time to live is not positive.
+func NewTTL[K comparable, V any](ttl time.Duration, options Options[K, V]) *TTL[K, V] {
+	if ttl <= 0 {
+		panic("time to live must be greater than zero")
+	}
+	options.TTL = ttl
+	return &TTL[K, V]{cache: newCache(&ttlPolicy[K, V]{}, options)}
+}
+
+// Expiry order of entries (closest to expiry first); since the time to live is fixed, this is the order in which entries were set.
+type ttlPolicy[K comparable, V any] struct {
+	m maps.OrderedMap[K, *entry[K, V]]
+}
+
+func (p *ttlPolicy[K, V]) add(e *entry[K, V]) {
+	p.m.Set(e.key, e)
+}
+
+func (p *ttlPolicy[K, V]) access(e *entry[K, V]) {
+}
+
+func (p *ttlPolicy[K, V]) remove(e *entry[K, V]) {
+	p.m.Delete(e.key)
+}
+
+func (p *ttlPolicy[K, V]) victim() *entry[K, V] {
+	for _, e := range p.m.All() {
+		return e
+	}
+	return nil
+}
+
+func (p *ttlPolicy[K, V]) expiryOrdered() bool {
+	return true
+}
@@ -0,0 +1,207 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deque
+
+import "iter"
+
+// Minimum capacity of the (non-empty) backing buffer of a deque.
+const minCapacity = 8
+
+// Double-ended queue.
+// All push and pop operations run in amortized constant time; the backing buffer is grown and shrunk as needed,
+// and removed elements are zeroed out, such that no memory is retained for them.
+// The zero value is an empty deque, ready to use. Deques are not safe for concurrent use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+// Create new deque, containing the given elements (from front to back).
+func New[T any](x ...T) *Deque[T] {
+	d := &Deque[T]{}
+	for _, y := range x {
+		d.PushBack(y)
+	}
+	return d
+}
+
+// Get number of elements.
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+// Add element at the front.
+func (d *Deque[T]) PushFront(x T) {
+	d.grow()
+	d.head = d.index(-1)
+	d.buf[d.head] = x
+	d.len++
+}
+
+// Add element at the back.
+func (d *Deque[T]) PushBack(x T) {
+	d.grow()
+	d.buf[d.index(d.len)] = x
+	d.len++
+}
+
+// Remove element from the front, and return it; the second return value reports whether the deque was non-empty.
+func (d *Deque[T]) PopFront() (x T, ok bool) {
+	if d.len == 0 {
+		return
+	}
+	var zero T
+	x, d.buf[d.head] = d.buf[d.head], zero
+	d.head = d.index(1)
+	d.len--
+	d.shrink()
+	return x, true
+}
+
+// Remove element from the back, and return it; the second return value reports whether the deque was non-empty.
+func (d *Deque[T]) PopBack() (x T, ok bool) {
+	if d.len == 0 {
+		return
+	}
+	var zero T
+	i := d.index(d.len - 1)
+	x, d.buf[i] = d.buf[i], zero
+	d.len--
+	d.shrink()
+	return x, true
+}
+
+// Get element at the front without removing it; the second return value reports whether the deque is non-empty.
+func (d *Deque[T]) Front() (T, bool) {
+	return d.At(0)
+}
+
+// Get element at the back without removing it; the second return value reports whether the deque is non-empty.
+func (d *Deque[T]) Back() (T, bool) {
+	return d.At(d.len - 1)
+}
+
+// Get element at given (zero-based) position, counted from the front; the second return value
+// reports whether i is within range.
+func (d *Deque[T]) At(i int) (x T, ok bool) {
+	if i < 0 || i >= d.len {
+		return
+	}
+	return d.buf[d.index(i)], true
+}
+
+// Replace element at given (zero-based) position, counted from the front; reports whether i is within range.
+func (d *Deque[T]) Set(i int, x T) bool {
+	if i < 0 || i >= d.len {
+		return false
+	}
+	d.buf[d.index(i)] = x
+	return true
+}
+
+// Rotate deque by n steps: for positive n, the first n elements are moved to the back (one by one);
+// for negative n, the last -n elements are moved to the front.
+// Runs in time proportional to min(|n| mod Len(), Len() - |n| mod Len()).
+func (d *Deque[T]) Rotate(n int) {
+	if d.len <= 1 {
+		return
+	}
+	n %= d.len
+	if n < 0 {
+		n += d.len
+	}
+	if n > d.len/2 {
+		n -= d.len
+	}
+	if d.len == len(d.buf) {
+		// buffer is full, so rotating is just moving the head
+		d.head = d.index(n)
+		return
+	}
+	for ; n > 0; n-- {
+		x, _ := d.PopFront()
+		d.PushBack(x)
+	}
+	for ; n < 0; n++ {
+		x, _ := d.PopBack()
+		d.PushFront(x)
+	}
+}
+
+// Remove all elements.
+func (d *Deque[T]) Clear() {
+	*d = Deque[T]{}
+}
+
+// Get elements as slice, from front to back.
+// Will return an empty non-nil slice in case the deque is empty.
+func (d *Deque[T]) Values() []T {
+	r := make([]T, d.len)
+	for i := range r {
+		r[i] = d.buf[d.index(i)]
+	}
+	return r
+}
+
+// Get all elements as sequence, from front to back.
+// The deque must not be modified while iterating.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.len; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Get all elements as sequence, from back to front.
+// The deque must not be modified while iterating.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.len - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Get buffer index of the element at position i (which may be negative, or exceed the length).
+func (d *Deque[T]) index(i int) int {
+	n := len(d.buf)
+	return ((d.head+i)%n + n) % n
+}
+
+// Ensure there is room for one more element.
+func (d *Deque[T]) grow() {
+	if d.len < len(d.buf) {
+		return
+	}
+	d.resize(max(2*len(d.buf), minCapacity))
+}
+
+// Release memory if the buffer is mostly empty.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCapacity && d.len <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+func (d *Deque[T]) resize(n int) {
+	buf := make([]T, n)
+	if d.len > 0 {
+		if d.head+d.len <= len(d.buf) {
+			copy(buf, d.buf[d.head:d.head+d.len])
+		} else {
+			k := copy(buf, d.buf[d.head:])
+			copy(buf[k:], d.buf[:d.len-k])
+		}
+	}
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,146 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deque_test
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/deque"
+	"github.com/sap/go-generics/slices"
+)
+
+func TestDeque(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Deque Suite")
+}
+
+var _ = Describe("deque", func() {
+	var emptyDeque *deque.Deque[int]
+	var dequeA *deque.Deque[int]
+
+	BeforeEach(func() {
+		emptyDeque = &deque.Deque[int]{}
+		dequeA = deque.New(1, 2, 3, 4, 5)
+	})
+
+	Describe("tests for an empty deque", func() {
+		It("should behave like an empty deque", func() {
+			Expect(emptyDeque.Len()).To(Equal(0))
+			_, ok := emptyDeque.PopFront()
+			Expect(ok).To(BeFalse())
+			_, ok = emptyDeque.PopBack()
+			Expect(ok).To(BeFalse())
+			_, ok = emptyDeque.Front()
+			Expect(ok).To(BeFalse())
+			_, ok = emptyDeque.Back()
+			Expect(ok).To(BeFalse())
+			Expect(emptyDeque.Set(0, 1)).To(BeFalse())
+			emptyDeque.Rotate(3)
+			Expect(emptyDeque.Values()).To(Equal([]int{}))
+		})
+	})
+
+	Describe("tests for push and pop operations", func() {
+		It("should work at both ends", func() {
+			emptyDeque.PushBack(2)
+			emptyDeque.PushFront(1)
+			emptyDeque.PushBack(3)
+			Expect(emptyDeque.Values()).To(Equal([]int{1, 2, 3}))
+			x, ok := emptyDeque.PopFront()
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal(1))
+			x, _ = emptyDeque.PopBack()
+			Expect(x).To(Equal(3))
+			x, _ = emptyDeque.Front()
+			Expect(x).To(Equal(2))
+			x, _ = emptyDeque.Back()
+			Expect(x).To(Equal(2))
+		})
+	})
+
+	Describe("tests for At() and Set()", func() {
+		It("should access elements by position", func() {
+			x, ok := dequeA.At(1)
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal(2))
+			_, ok = dequeA.At(5)
+			Expect(ok).To(BeFalse())
+			Expect(dequeA.Set(4, 0)).To(BeTrue())
+			Expect(dequeA.Values()).To(Equal([]int{1, 2, 3, 4, 0}))
+		})
+	})
+
+	Describe("tests for Rotate()", func() {
+		It("should rotate in both directions", func() {
+			dequeA.Rotate(2)
+			Expect(dequeA.Values()).To(Equal([]int{3, 4, 5, 1, 2}))
+			dequeA.Rotate(-3)
+			Expect(dequeA.Values()).To(Equal([]int{5, 1, 2, 3, 4}))
+			dequeA.Rotate(9)
+			Expect(dequeA.Values()).To(Equal([]int{4, 5, 1, 2, 3}))
+		})
+		It("should rotate a full buffer", func() {
+			d := deque.New(1, 2, 3, 4, 5, 6, 7, 8)
+			d.Rotate(3)
+			Expect(d.Values()).To(Equal([]int{4, 5, 6, 7, 8, 1, 2, 3}))
+			d.Rotate(-1)
+			Expect(d.Values()).To(Equal([]int{3, 4, 5, 6, 7, 8, 1, 2}))
+		})
+	})
+
+	Describe("tests for All(), Backward() and Clear()", func() {
+		It("should iterate and clear", func() {
+			Expect(slices.FromSeq(dequeA.All())).To(Equal([]int{1, 2, 3, 4, 5}))
+			Expect(slices.FromSeq(dequeA.Backward())).To(Equal([]int{5, 4, 3, 2, 1}))
+			dequeA.Clear()
+			Expect(dequeA.Len()).To(Equal(0))
+		})
+	})
+
+	Describe("tests with random operations", func() {
+		It("should behave like a slice", func() {
+			r := rand.New(rand.NewSource(1))
+			d := &deque.Deque[int]{}
+			var ref []int
+			for i := 0; i < 10000; i++ {
+				switch r.Intn(5) {
+				case 0:
+					d.PushFront(i)
+					ref = append([]int{i}, ref...)
+				case 1:
+					d.PushBack(i)
+					ref = append(ref, i)
+				case 2:
+					x, ok := d.PopFront()
+					Expect(ok).To(Equal(len(ref) > 0))
+					if ok {
+						Expect(x).To(Equal(ref[0]))
+						ref = ref[1:]
+					}
+				case 3:
+					x, ok := d.PopBack()
+					Expect(ok).To(Equal(len(ref) > 0))
+					if ok {
+						Expect(x).To(Equal(ref[len(ref)-1]))
+						ref = ref[:len(ref)-1]
+					}
+				case 4:
+					if len(ref) > 0 {
+						n := r.Intn(2*len(ref)) - len(ref)
+						d.Rotate(n)
+						k := ((n % len(ref)) + len(ref)) % len(ref)
+						ref = append(append([]int{}, ref[k:]...), ref[:k]...)
+					}
+				}
+				Expect(d.Len()).To(Equal(len(ref)))
+			}
+			Expect(d.Values()).To(Equal(append([]int{}, ref...)))
+		})
+	})
+})
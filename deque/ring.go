@@ -0,0 +1,116 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deque
+
+import "iter"
+
+// Ring buffer with fixed capacity.
+// Once the buffer is full, pushing an element overwrites the oldest one.
+// Always create ring buffers with the NewRing() function, do not use uninitialized ring buffers
+// (i.e. ring buffers having the zero value). Ring buffers are not safe for concurrent use.
+type Ring[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+// Create new ring buffer with given capacity.
+// Panics if the capacity is not positive.
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity <= 0 {
+		panic("ring capacity must be greater than zero")
+	}
+	return &Ring[T]{buf: make([]T, capacity)}
+}
+
+// Get number of elements.
+func (r *Ring[T]) Len() int {
+	return r.len
+}
+
+// Get capacity.
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Report whether the ring buffer is full, such that the next Push() will overwrite the oldest element.
+func (r *Ring[T]) Full() bool {
+	return r.len == len(r.buf)
+}
+
+// Add element as newest element. If the buffer was full, the oldest element is overwritten and returned;
+// the second return value reports whether this was the case.
+func (r *Ring[T]) Push(x T) (evicted T, ok bool) {
+	if r.len == len(r.buf) {
+		evicted, ok = r.buf[r.head], true
+		r.buf[r.head] = x
+		r.head = (r.head + 1) % len(r.buf)
+		return
+	}
+	r.buf[(r.head+r.len)%len(r.buf)] = x
+	r.len++
+	return
+}
+
+// Remove oldest element, and return it; the second return value reports whether the buffer was non-empty.
+func (r *Ring[T]) Pop() (x T, ok bool) {
+	if r.len == 0 {
+		return
+	}
+	var zero T
+	x, r.buf[r.head] = r.buf[r.head], zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.len--
+	return x, true
+}
+
+// Get oldest element; the second return value reports whether the buffer is non-empty.
+func (r *Ring[T]) Oldest() (T, bool) {
+	return r.At(0)
+}
+
+// Get newest element; the second return value reports whether the buffer is non-empty.
+func (r *Ring[T]) Newest() (T, bool) {
+	return r.At(r.len - 1)
+}
+
+// Get element at given (zero-based) position, counted from the oldest element; the second return value
+// reports whether i is within range.
+func (r *Ring[T]) At(i int) (x T, ok bool) {
+	if i < 0 || i >= r.len {
+		return
+	}
+	return r.buf[(r.head+i)%len(r.buf)], true
+}
+
+// Remove all elements.
+func (r *Ring[T]) Clear() {
+	clear(r.buf)
+	r.head = 0
+	r.len = 0
+}
+
+// Get elements as slice, from oldest to newest.
+// Will return an empty non-nil slice in case the buffer is empty.
+func (r *Ring[T]) Values() []T {
+	s := make([]T, r.len)
+	for i := range s {
+		s[i] = r.buf[(r.head+i)%len(r.buf)]
+	}
+	return s
+}
+
+// Get all elements as sequence, from oldest to newest.
+// The ring buffer must not be modified while iterating.
+func (r *Ring[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.len; i++ {
+			if !yield(r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
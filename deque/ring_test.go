@@ -0,0 +1,81 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deque_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/deque"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("ring", func() {
+	var ring *deque.Ring[int]
+
+	BeforeEach(func() {
+		ring = deque.NewRing[int](3)
+	})
+
+	Describe("tests for NewRing()", func() {
+		It("should panic for non-positive capacity", func() {
+			Expect(func() { deque.NewRing[int](0) }).To(Panic())
+		})
+	})
+
+	Describe("tests for an empty ring", func() {
+		It("should behave like an empty ring", func() {
+			Expect(ring.Len()).To(Equal(0))
+			Expect(ring.Cap()).To(Equal(3))
+			Expect(ring.Full()).To(BeFalse())
+			_, ok := ring.Pop()
+			Expect(ok).To(BeFalse())
+			_, ok = ring.Oldest()
+			Expect(ok).To(BeFalse())
+			_, ok = ring.Newest()
+			Expect(ok).To(BeFalse())
+			Expect(ring.Values()).To(Equal([]int{}))
+		})
+	})
+
+	Describe("tests for Push()", func() {
+		It("should overwrite the oldest element when full", func() {
+			for i := 1; i <= 3; i++ {
+				_, ok := ring.Push(i)
+				Expect(ok).To(BeFalse())
+			}
+			Expect(ring.Full()).To(BeTrue())
+			x, ok := ring.Push(4)
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal(1))
+			ring.Push(5)
+			Expect(ring.Values()).To(Equal([]int{3, 4, 5}))
+			Expect(slices.FromSeq(ring.All())).To(Equal([]int{3, 4, 5}))
+			x, _ = ring.Oldest()
+			Expect(x).To(Equal(3))
+			x, _ = ring.Newest()
+			Expect(x).To(Equal(5))
+			x, _ = ring.At(1)
+			Expect(x).To(Equal(4))
+		})
+	})
+
+	Describe("tests for Pop() and Clear()", func() {
+		It("should remove the oldest elements", func() {
+			ring.Push(1)
+			ring.Push(2)
+			x, ok := ring.Pop()
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal(1))
+			ring.Push(3)
+			ring.Push(4)
+			Expect(ring.Values()).To(Equal([]int{2, 3, 4}))
+			ring.Clear()
+			Expect(ring.Len()).To(Equal(0))
+			ring.Push(5)
+			Expect(ring.Values()).To(Equal([]int{5}))
+		})
+	})
+})
@@ -0,0 +1,234 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package heap
+
+import (
+	"github.com/sap/go-generics/slices"
+)
+
+// Binary heap (priority queue).
+// Pop() and Peek() return the smallest element, as defined by the heap's ordering; to obtain a max-heap, invert the ordering.
+// A heap may be bounded; a bounded heap keeps at most the given number of elements, discarding the smallest elements
+// (such that it retains the top-k elements pushed so far).
+// Always create heaps with the New(), NewBy(), NewBounded() or NewBoundedBy() functions, do not use uninitialized heaps
+// (i.e. heaps having the zero value). Heaps are not safe for concurrent use.
+type Heap[T any] struct {
+	items []*Handle[T]
+	f     func(x, y T) bool
+	limit int
+}
+
+// Handle referring to an element in a heap.
+// Handles are returned by Push() and can be used to update or remove the element later.
+type Handle[T any] struct {
+	value T
+	index int
+	heap  *Heap[T]
+}
+
+// Get the value of the element referred to by the handle.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// Report whether the element referred to by the handle is (still) contained in its heap.
+func (h *Handle[T]) Valid() bool {
+	return h.index >= 0
+}
+
+// Create new heap of orderable elements, containing the given elements.
+// Building the heap is done in linear time.
+func New[T slices.Orderable](x ...T) *Heap[T] {
+	return NewBy(greater[T], x...)
+}
+
+// Create new heap, ordering elements by given comparator function, containing the given elements.
+// As for slices.SortBy(), the comparator function f(x,y) must return true if x is larger than y, and false otherwise.
+// Building the heap is done in linear time.
+func NewBy[T any](f func(x, y T) bool, x ...T) *Heap[T] {
+	return NewBoundedBy(0, f, x...)
+}
+
+// Create new bounded heap of orderable elements, keeping at most k elements (the largest ones) of the given elements.
+// A non-positive k means that the heap is unbounded.
+func NewBounded[T slices.Orderable](k int, x ...T) *Heap[T] {
+	return NewBoundedBy(k, greater[T], x...)
+}
+
+// Create new bounded heap, ordering elements by given comparator function, keeping at most k elements
+// (the largest ones) of the given elements.
+// A non-positive k means that the heap is unbounded.
+func NewBoundedBy[T any](k int, f func(x, y T) bool, x ...T) *Heap[T] {
+	h := &Heap[T]{items: make([]*Handle[T], len(x)), f: f, limit: max(k, 0)}
+	for i, y := range x {
+		h.items[i] = &Handle[T]{value: y, index: i, heap: h}
+	}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	if h.limit > 0 {
+		for len(h.items) > h.limit {
+			h.remove(0)
+		}
+	}
+	return h
+}
+
+// Get number of elements.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Get the maximum number of elements of a bounded heap; returns zero for unbounded heaps.
+func (h *Heap[T]) Limit() int {
+	return h.limit
+}
+
+// Push element to heap, and return a handle referring to it.
+// If the heap is bounded and full, the smallest element will be discarded (which may be the pushed element itself;
+// in that case, the returned handle is not valid).
+func (h *Heap[T]) Push(x T) *Handle[T] {
+	e := &Handle[T]{value: x, index: -1, heap: h}
+	if h.limit > 0 && len(h.items) >= h.limit {
+		if !h.less(0, e) {
+			return e
+		}
+		h.remove(0)
+	}
+	e.index = len(h.items)
+	h.items = append(h.items, e)
+	h.up(e.index)
+	return e
+}
+
+// Remove the smallest element from the heap, and return it; the second return value reports whether the heap was non-empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var x T
+		return x, false
+	}
+	return h.remove(0).value, true
+}
+
+// Get the smallest element without removing it; the second return value reports whether the heap is non-empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var x T
+		return x, false
+	}
+	return h.items[0].value, true
+}
+
+// Restore the heap ordering after the value of the element referred to by the given handle was changed in place
+// (e.g. if T is a pointer type). Reports whether the handle is valid for this heap.
+func (h *Heap[T]) Fix(e *Handle[T]) bool {
+	if !h.owns(e) {
+		return false
+	}
+	if !h.down(e.index) {
+		h.up(e.index)
+	}
+	return true
+}
+
+// Replace the value of the element referred to by the given handle, and restore the heap ordering.
+// Reports whether the handle is valid for this heap.
+func (h *Heap[T]) Update(e *Handle[T], x T) bool {
+	if !h.owns(e) {
+		return false
+	}
+	e.value = x
+	return h.Fix(e)
+}
+
+// Remove the element referred to by the given handle; reports whether the handle was valid for this heap.
+func (h *Heap[T]) Remove(e *Handle[T]) bool {
+	if !h.owns(e) {
+		return false
+	}
+	h.remove(e.index)
+	return true
+}
+
+// Get the elements of the heap as slice; order is not predictable.
+// Will return an empty non-nil slice in case the heap is empty.
+func (h *Heap[T]) Values() []T {
+	return slices.Collect(h.items, (*Handle[T]).Value)
+}
+
+// Get the elements of the heap as slice, sorted in ascending order; the heap remains unchanged.
+// Will return an empty non-nil slice in case the heap is empty.
+func (h *Heap[T]) Sorted() []T {
+	return slices.SortBy(h.Values(), h.f)
+}
+
+func (h *Heap[T]) owns(e *Handle[T]) bool {
+	return e != nil && e.heap == h && e.index >= 0
+}
+
+// Report whether the element at index i is strictly smaller than e.
+func (h *Heap[T]) less(i int, e *Handle[T]) bool {
+	return h.f(e.value, h.items[i].value)
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *Heap[T]) up(j int) {
+	for j > 0 {
+		i := (j - 1) / 2
+		if !h.less(j, h.items[i]) {
+			break
+		}
+		h.swap(i, j)
+		j = i
+	}
+}
+
+// Sift element at index i0 down; reports whether the element was moved.
+func (h *Heap[T]) down(i0 int) bool {
+	n := len(h.items)
+	i := i0
+	for {
+		j := 2*i + 1
+		if j >= n {
+			break
+		}
+		if k := j + 1; k < n && h.less(k, h.items[j]) {
+			j = k
+		}
+		if !h.less(j, h.items[i]) {
+			break
+		}
+		h.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
+
+func (h *Heap[T]) remove(i int) *Handle[T] {
+	n := len(h.items) - 1
+	if i != n {
+		h.swap(i, n)
+	}
+	e := h.items[n]
+	h.items[n] = nil
+	h.items = h.items[:n]
+	if i != n {
+		if !h.down(i) {
+			h.up(i)
+		}
+	}
+	e.index = -1
+	return e
+}
+
+func greater[T slices.Orderable](x, y T) bool {
+	return x > y
+}
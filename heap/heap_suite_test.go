@@ -0,0 +1,149 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package heap_test
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/heap"
+	"github.com/sap/go-generics/slices"
+)
+
+func TestHeap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Heap Suite")
+}
+
+func drain[T any](h *heap.Heap[T]) []T {
+	r := make([]T, 0)
+	for h.Len() > 0 {
+		x, _ := h.Pop()
+		r = append(r, x)
+	}
+	return r
+}
+
+var _ = Describe("heap", func() {
+	var sliceD []int
+
+	BeforeEach(func() {
+		sliceD = []int{9, 6, 5, 6, 3, 7, 7, 1, 2, 8}
+	})
+
+	AfterEach(func() {
+		Expect(sliceD).To(Equal([]int{9, 6, 5, 6, 3, 7, 7, 1, 2, 8}))
+	})
+
+	Describe("tests for an empty heap", func() {
+		It("should behave like an empty heap", func() {
+			h := heap.New[int]()
+			Expect(h.Len()).To(Equal(0))
+			_, ok := h.Pop()
+			Expect(ok).To(BeFalse())
+			_, ok = h.Peek()
+			Expect(ok).To(BeFalse())
+			Expect(h.Values()).To(Equal([]int{}))
+			Expect(h.Sorted()).To(Equal([]int{}))
+		})
+	})
+
+	Describe("tests for New() and Pop()", func() {
+		It("should pop the elements in ascending order", func() {
+			h := heap.New(sliceD...)
+			Expect(h.Len()).To(Equal(10))
+			x, ok := h.Peek()
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal(1))
+			Expect(h.Sorted()).To(Equal(slices.Sort(sliceD)))
+			Expect(drain(h)).To(Equal(slices.Sort(sliceD)))
+		})
+	})
+
+	Describe("tests for NewBy() and Push()", func() {
+		It("should pop the elements in the order defined by the comparator", func() {
+			h := heap.NewBy(func(x, y int) bool { return x < y })
+			for _, x := range sliceD {
+				h.Push(x)
+			}
+			Expect(drain(h)).To(Equal(slices.Reverse(slices.Sort(sliceD))))
+		})
+	})
+
+	Describe("tests for handles", func() {
+		It("should allow updating and removing elements", func() {
+			h := heap.New[int]()
+			handles := slices.Collect(sliceD, h.Push)
+			Expect(handles[0].Value()).To(Equal(9))
+			Expect(h.Update(handles[0], 0)).To(BeTrue())
+			x, _ := h.Peek()
+			Expect(x).To(Equal(0))
+			Expect(h.Remove(handles[7])).To(BeTrue())
+			Expect(handles[7].Valid()).To(BeFalse())
+			Expect(h.Remove(handles[7])).To(BeFalse())
+			Expect(h.Update(handles[7], 5)).To(BeFalse())
+			Expect(h.Update(handles[1], 10)).To(BeTrue())
+			Expect(drain(h)).To(Equal([]int{0, 2, 3, 5, 6, 7, 7, 8, 10}))
+			Expect(handles[0].Valid()).To(BeFalse())
+			Expect(heap.New[int]().Remove(handles[2])).To(BeFalse())
+		})
+		It("should fix elements changed in place", func() {
+			type item struct{ prio int }
+			h := heap.NewBy(func(x, y *item) bool { return x.prio > y.prio })
+			a := h.Push(&item{prio: 1})
+			h.Push(&item{prio: 2})
+			a.Value().prio = 3
+			Expect(h.Fix(a)).To(BeTrue())
+			x, _ := h.Pop()
+			Expect(x.prio).To(Equal(2))
+		})
+	})
+
+	Describe("tests for bounded heaps", func() {
+		It("should keep the top-k elements", func() {
+			h := heap.NewBounded(3, sliceD...)
+			Expect(h.Limit()).To(Equal(3))
+			Expect(h.Sorted()).To(Equal([]int{7, 8, 9}))
+			Expect(h.Push(1).Valid()).To(BeFalse())
+			Expect(h.Push(10).Valid()).To(BeTrue())
+			Expect(drain(h)).To(Equal([]int{8, 9, 10}))
+		})
+		It("should keep the top-k elements when pushing", func() {
+			h := heap.NewBoundedBy(4, func(x, y int) bool { return x > y })
+			for _, x := range sliceD {
+				h.Push(x)
+			}
+			Expect(h.Len()).To(Equal(4))
+			Expect(drain(h)).To(Equal([]int{7, 7, 8, 9}))
+		})
+	})
+
+	Describe("tests with random operations", func() {
+		It("should behave like a sorted slice", func() {
+			r := rand.New(rand.NewSource(1))
+			h := heap.New[int]()
+			var handles []*heap.Handle[int]
+			for i := 0; i < 2000; i++ {
+				switch r.Intn(4) {
+				case 0:
+					if len(handles) > 0 {
+						h.Remove(handles[r.Intn(len(handles))])
+					}
+				case 1:
+					if len(handles) > 0 {
+						h.Update(handles[r.Intn(len(handles))], r.Intn(1000))
+					}
+				default:
+					handles = append(handles, h.Push(r.Intn(1000)))
+				}
+			}
+			values := h.Values()
+			Expect(drain(h)).To(Equal(slices.Sort(values)))
+		})
+	})
+})
@@ -0,0 +1,102 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package reflection_test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/internal/reflection"
+)
+
+func TestReflection(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reflection Suite")
+}
+
+type myString string
+
+func (s myString) MarshalText() ([]byte, error) {
+	return []byte("my:" + s), nil
+}
+
+func (s *myString) UnmarshalText(text []byte) error {
+	*s = myString(text[3:])
+	return nil
+}
+
+var errUnsupported = errors.New("unsupported")
+
+func marshal[T any](x T, rules reflection.TextRules) (string, error) {
+	text, ok, err := reflection.MarshalText(x, rules)
+	if !ok {
+		return "", errUnsupported
+	}
+	return text, err
+}
+
+func unmarshal[T any](text string, rules reflection.TextRules) (T, error) {
+	x, ok, err := reflection.UnmarshalText[T](text, rules)
+	if !ok {
+		return x, errUnsupported
+	}
+	return x, err
+}
+
+var _ = Describe("reflection", func() {
+	Describe("tests for Sort()", func() {
+		It("should sort orderable kinds", func() {
+			ints := []int{3, -1, 2}
+			Expect(reflection.Sort(ints)).To(BeTrue())
+			Expect(ints).To(Equal([]int{-1, 2, 3}))
+			uints := []uint8{3, 1, 2}
+			Expect(reflection.Sort(uints)).To(BeTrue())
+			Expect(uints).To(Equal([]uint8{1, 2, 3}))
+			floats := []float64{2.5, -1, 0}
+			Expect(reflection.Sort(floats)).To(BeTrue())
+			Expect(floats).To(Equal([]float64{-1, 0, 2.5}))
+			strings := []myString{"b", "c", "a"}
+			Expect(reflection.Sort(strings)).To(BeTrue())
+			Expect(strings).To(Equal([]myString{"a", "b", "c"}))
+		})
+		It("should leave other kinds unchanged", func() {
+			bools := []bool{true, false}
+			Expect(reflection.Sort(bools)).To(BeFalse())
+			Expect(bools).To(Equal([]bool{true, false}))
+			Expect(reflection.Sort([]struct{}(nil))).To(BeFalse())
+		})
+	})
+
+	Describe("tests for MarshalText() and UnmarshalText()", func() {
+		It("should follow the rules of encoding/json for map keys", func() {
+			Expect(marshal(myString("a"), reflection.JSONKeyRules)).To(Equal("a"))
+			Expect(marshal(int8(-3), reflection.JSONKeyRules)).To(Equal("-3"))
+			Expect(marshal(netip.MustParseAddr("10.0.0.1"), reflection.JSONKeyRules)).To(Equal("10.0.0.1"))
+			Expect(marshal(true, reflection.JSONKeyRules)).Error().To(MatchError(errUnsupported))
+			Expect(marshal(1.5, reflection.JSONKeyRules)).Error().To(MatchError(errUnsupported))
+			Expect(unmarshal[myString]("a", reflection.JSONKeyRules)).To(Equal(myString("a")))
+			Expect(unmarshal[uint16]("7", reflection.JSONKeyRules)).To(Equal(uint16(7)))
+			Expect(unmarshal[netip.Addr]("10.0.0.1", reflection.JSONKeyRules)).To(Equal(netip.MustParseAddr("10.0.0.1")))
+			_, err := unmarshal[uint8]("256", reflection.JSONKeyRules)
+			Expect(err).To(HaveOccurred())
+			Expect(unmarshal[float64]("1.5", reflection.JSONKeyRules)).Error().To(MatchError(errUnsupported))
+		})
+		It("should prefer encoding.TextMarshaler for values", func() {
+			Expect(marshal(myString("a"), reflection.ValueRules)).To(Equal("my:a"))
+			Expect(marshal(true, reflection.ValueRules)).To(Equal("true"))
+			Expect(marshal(float32(1.5), reflection.ValueRules)).To(Equal("1.5"))
+			Expect(marshal([2]int{}, reflection.ValueRules)).Error().To(MatchError(errUnsupported))
+			Expect(unmarshal[myString]("my:a", reflection.ValueRules)).To(Equal(myString("a")))
+			Expect(unmarshal[bool]("true", reflection.ValueRules)).To(BeTrue())
+			Expect(unmarshal[float64]("1.5", reflection.ValueRules)).To(Equal(1.5))
+			Expect(unmarshal[bool]("maybe", reflection.ValueRules)).Error().To(HaveOccurred())
+			Expect(unmarshal[[2]int]("", reflection.ValueRules)).Error().To(MatchError(errUnsupported))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package reflection
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Sort slice in place, if the kind of T is orderable (that is, an integer, floating point or string kind);
+// reports whether the slice was sorted. For other kinds, the slice remains unchanged, and false is returned.
+// This allows to sort values of type parameters which are only constrained by comparable (or any).
+func Sort[T any](s []T) bool {
+	v := reflect.ValueOf(s)
+	var less func(i, j int) bool
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less = func(i, j int) bool { return v.Index(i).Int() < v.Index(j).Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		less = func(i, j int) bool { return v.Index(i).Uint() < v.Index(j).Uint() }
+	case reflect.Float32, reflect.Float64:
+		less = func(i, j int) bool { return v.Index(i).Float() < v.Index(j).Float() }
+	case reflect.String:
+		less = func(i, j int) bool { return v.Index(i).String() < v.Index(j).String() }
+	default:
+		return false
+	}
+	sort.Slice(s, less)
+	return true
+}
@@ -0,0 +1,104 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package reflection provides reflection based helpers shared by the generic packages of this module,
+// for type parameters which cannot be constrained any further (such as map keys or set elements).
+package reflection
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+)
+
+// Rules for converting values to and from text.
+type TextRules int
+
+const (
+	// Rules of encoding/json for map keys: values of string kind are used as they are (even if their type implements
+	// encoding.TextMarshaler); otherwise, the type must implement encoding.TextMarshaler, or be of an integer kind.
+	JSONKeyRules TextRules = iota
+	// Types implementing encoding.TextMarshaler are converted that way; otherwise values of string, boolean,
+	// integer and floating point kinds are supported (and converted by means of the strconv package).
+	ValueRules
+)
+
+// Convert value to text according to the given rules.
+// The second return value reports whether the type of x is supported; if it is false, the error is nil.
+func MarshalText[T any](x T, rules TextRules) (string, bool, error) {
+	v := reflect.ValueOf(&x).Elem()
+	if rules == JSONKeyRules && v.Kind() == reflect.String {
+		return v.String(), true, nil
+	}
+	if m, ok := any(x).(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), true, err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	}
+	if rules != ValueRules {
+		return "", false, nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), true, nil
+	}
+	return "", false, nil
+}
+
+// Convert text to value according to the given rules.
+// The second return value reports whether T is supported; if it is false, the error is nil.
+func UnmarshalText[T any](text string, rules TextRules) (x T, ok bool, err error) {
+	v := reflect.ValueOf(&x).Elem()
+	if rules == JSONKeyRules && v.Kind() == reflect.String {
+		v.SetString(text)
+		return x, true, nil
+	}
+	if u, isUnmarshaler := any(&x).(encoding.TextUnmarshaler); isUnmarshaler {
+		err = u.UnmarshalText([]byte(text))
+		return x, true, err
+	}
+	ok = true
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(text, 10, v.Type().Bits())
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		u, err = strconv.ParseUint(text, 10, v.Type().Bits())
+		v.SetUint(u)
+	case reflect.String:
+		ok = rules == ValueRules
+		if ok {
+			v.SetString(text)
+		}
+	case reflect.Bool:
+		ok = rules == ValueRules
+		if ok {
+			var b bool
+			b, err = strconv.ParseBool(text)
+			v.SetBool(b)
+		}
+	case reflect.Float32, reflect.Float64:
+		ok = rules == ValueRules
+		if ok {
+			var f float64
+			f, err = strconv.ParseFloat(text, v.Type().Bits())
+			v.SetFloat(f)
+		}
+	default:
+		ok = false
+	}
+	return
+}
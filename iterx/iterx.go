@@ -0,0 +1,264 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package iterx
+
+import (
+	"iter"
+
+	"github.com/sap/go-generics/pairs"
+)
+
+// Map sequence through given function.
+// The returned sequence yields the elements of the input sequence mapped through the provided function f.
+func Map[S any, T any](seq iter.Seq[S], f func(S) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range seq {
+			if !yield(f(x)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter sequence by given function.
+// The returned sequence yields those elements of the input sequence, for which the provided function f evaluates to true.
+func Filter[T any](seq iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range seq {
+			if f(x) && !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Get first n elements of a sequence.
+// If the input sequence yields less than n elements, all of them will be yielded.
+func Take[T any](seq iter.Seq[T], n uint) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		i := uint(0)
+		for x := range seq {
+			if !yield(x) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip first n elements of a sequence.
+// If the input sequence yields less than n elements, the returned sequence will not yield any elements.
+func Drop[T any](seq iter.Seq[T], n uint) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := uint(0)
+		for x := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Get leading elements of a sequence, as long as the given function evaluates to true.
+func TakeWhile[T any](seq iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range seq {
+			if !f(x) || !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Skip leading elements of a sequence, as long as the given function evaluates to true.
+// Once f evaluated to false, all remaining elements will be yielded (without evaluating f again).
+func DropWhile[T any](seq iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for x := range seq {
+			if dropping && f(x) {
+				continue
+			}
+			dropping = false
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Zip two sequences.
+// The returned sequence yields pairs of corresponding elements; it stops as soon as one of the input sequences is exhausted.
+func Zip[S any, T any](s iter.Seq[S], t iter.Seq[T]) iter.Seq[pairs.Pair[S, T]] {
+	return func(yield func(pairs.Pair[S, T]) bool) {
+		next, stop := iter.Pull(t)
+		defer stop()
+		for x := range s {
+			y, ok := next()
+			if !ok {
+				return
+			}
+			if !yield(pairs.Pair[S, T]{X: x, Y: y}) {
+				return
+			}
+		}
+	}
+}
+
+// Chain sequences.
+// The returned sequence yields the elements of all input sequences, one after the other.
+func Chain[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for x := range seq {
+				if !yield(x) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Flatten sequence of sequences.
+// The returned sequence yields the elements of all sequences yielded by the input sequence, one after the other.
+func Flatten[T any](seq iter.Seq[iter.Seq[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for s := range seq {
+			for x := range s {
+				if !yield(x) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Get sliding windows of a sequence.
+// The returned sequence yields all windows of n consecutive elements of the input sequence, as newly allocated slices.
+// If the input sequence yields less than n elements, the returned sequence will not yield anything.
+// Panics if n is zero.
+func Window[T any](seq iter.Seq[T], n uint) iter.Seq[[]T] {
+	if n == 0 {
+		panic("window size must be greater than zero")
+	}
+	return func(yield func([]T) bool) {
+		w := make([]T, 0, n)
+		for x := range seq {
+			if uint(len(w)) == n {
+				w = w[1:]
+			}
+			w = append(w, x)
+			if uint(len(w)) == n {
+				r := make([]T, n)
+				copy(r, w)
+				if !yield(r) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Split sequence into chunks.
+// The returned sequence yields consecutive chunks of n elements of the input sequence, as newly allocated slices;
+// the last chunk may contain less than n elements.
+// Panics if n is zero.
+func Chunk[T any](seq iter.Seq[T], n uint) iter.Seq[[]T] {
+	if n == 0 {
+		panic("chunk size must be greater than zero")
+	}
+	return func(yield func([]T) bool) {
+		var c []T
+		for x := range seq {
+			if c == nil {
+				c = make([]T, 0, n)
+			}
+			c = append(c, x)
+			if uint(len(c)) == n {
+				if !yield(c) {
+					return
+				}
+				c = nil
+			}
+		}
+		if c != nil {
+			yield(c)
+		}
+	}
+}
+
+// Enumerate sequence.
+// The returned sequence yields the elements of the input sequence along with their (zero-based) index.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for x := range seq {
+			if !yield(i, x) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Reduce sequence by given function.
+// Starting with the provided initial value, f is applied to the accumulated value and each element of the sequence.
+// If the sequence does not yield any elements, the initial value will be returned.
+func Reduce[T any, U any](seq iter.Seq[T], init U, f func(U, T) U) U {
+	r := init
+	for x := range seq {
+		r = f(r, x)
+	}
+	return r
+}
+
+// Report whether the given boolean function evaluates to true for at least one element of the given sequence.
+// Returns false for empty sequences. Stops consuming the sequence at the first match.
+func Any[T any](seq iter.Seq[T], f func(T) bool) bool {
+	for x := range seq {
+		if f(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// Report whether the given boolean function evaluates to true for all elements of the given sequence.
+// Returns true for empty sequences. Stops consuming the sequence at the first mismatch.
+func All[T any](seq iter.Seq[T], f func(T) bool) bool {
+	for x := range seq {
+		if !f(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// Report whether the given boolean function evaluates to true for none of the elements of the given sequence.
+// Returns true for empty sequences. Stops consuming the sequence at the first match.
+func None[T any](seq iter.Seq[T], f func(T) bool) bool {
+	return !Any(seq, f)
+}
+
+// Count elements for which the given boolean function evaluates to true.
+func Count[T any](seq iter.Seq[T], f func(T) bool) (c int) {
+	for x := range seq {
+		if f(x) {
+			c++
+		}
+	}
+	return
+}
@@ -0,0 +1,235 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package iterx_test
+
+import (
+	"iter"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/iterx"
+	"github.com/sap/go-generics/pairs"
+	"github.com/sap/go-generics/slices"
+)
+
+func TestIterx(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Iterx Suite")
+}
+
+// Sequence of natural numbers (0, 1, 2, ...); never ends by itself.
+func naturals() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+var _ = Describe("iterx", func() {
+	var emptySeq iter.Seq[int]
+	var seqB iter.Seq[int]
+	var seqD iter.Seq[int]
+
+	BeforeEach(func() {
+		emptySeq = slices.Seq([]int{})
+		seqB = slices.Seq([]int{1, 2, 3})
+		seqD = slices.Seq([]int{9, 6, 5, 6, 3, 7, 7, 1, 2, 8})
+	})
+
+	Describe("tests for Map()", func() {
+		Context("with an empty sequence", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(iterx.Map(emptySeq, func(int) int { return -1 }))).To(BeEmpty())
+			})
+		})
+		Context("with a more complex sequence", func() {
+			It("should yield the mapped elements", func() {
+				Expect(slices.FromSeq(iterx.Map(seqB, func(x int) float64 { return float64(x) / 2 }))).To(Equal([]float64{0.5, 1, 1.5}))
+			})
+		})
+	})
+
+	Describe("tests for Filter()", func() {
+		Context("with an empty sequence", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(iterx.Filter(emptySeq, func(int) bool { return true }))).To(BeEmpty())
+			})
+		})
+		Context("with a more complex sequence", func() {
+			It("should yield the selected elements", func() {
+				Expect(slices.FromSeq(iterx.Filter(seqD, func(x int) bool { return x%2 != 0 }))).To(Equal([]int{9, 5, 3, 7, 7, 1}))
+			})
+		})
+	})
+
+	Describe("tests for Take()", func() {
+		Context("with an infinite sequence", func() {
+			It("should yield the first n elements", func() {
+				Expect(slices.FromSeq(iterx.Take(naturals(), 3))).To(Equal([]int{0, 1, 2}))
+			})
+		})
+		Context("with n = 0", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(iterx.Take(naturals(), 0))).To(BeEmpty())
+			})
+		})
+		Context("with n greater than the length of the sequence", func() {
+			It("should yield all elements", func() {
+				Expect(slices.FromSeq(iterx.Take(seqB, 4))).To(Equal([]int{1, 2, 3}))
+			})
+		})
+	})
+
+	Describe("tests for Drop()", func() {
+		Context("with n less than the length of the sequence", func() {
+			It("should yield the remaining elements", func() {
+				Expect(slices.FromSeq(iterx.Drop(seqB, 2))).To(Equal([]int{3}))
+			})
+		})
+		Context("with n greater than the length of the sequence", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(iterx.Drop(seqB, 4))).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("tests for TakeWhile() and DropWhile()", func() {
+		Context("with a more complex sequence", func() {
+			It("should split the sequence at the first mismatch", func() {
+				f := func(x int) bool {
+					return x > 4
+				}
+				Expect(slices.FromSeq(iterx.TakeWhile(seqD, f))).To(Equal([]int{9, 6, 5, 6}))
+				Expect(slices.FromSeq(iterx.DropWhile(seqD, f))).To(Equal([]int{3, 7, 7, 1, 2, 8}))
+			})
+		})
+		Context("with an infinite sequence", func() {
+			It("should stop taking at the first mismatch", func() {
+				Expect(slices.FromSeq(iterx.TakeWhile(naturals(), func(x int) bool { return x < 3 }))).To(Equal([]int{0, 1, 2}))
+			})
+		})
+	})
+
+	Describe("tests for Zip()", func() {
+		Context("with sequences of different length", func() {
+			It("should stop at the end of the shorter sequence", func() {
+				Expect(slices.FromSeq(iterx.Zip(seqB, slices.Seq([]string{"a", "b"})))).To(Equal([]pairs.Pair[int, string]{{X: 1, Y: "a"}, {X: 2, Y: "b"}}))
+				Expect(slices.FromSeq(iterx.Zip(naturals(), slices.Seq([]string{"a"})))).To(Equal([]pairs.Pair[int, string]{{X: 0, Y: "a"}}))
+			})
+		})
+	})
+
+	Describe("tests for Chain() and Flatten()", func() {
+		Context("with no sequences", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(iterx.Chain[int]())).To(BeEmpty())
+			})
+		})
+		Context("with several sequences", func() {
+			It("should yield all elements in order", func() {
+				Expect(slices.FromSeq(iterx.Chain(seqB, emptySeq, seqB))).To(Equal([]int{1, 2, 3, 1, 2, 3}))
+				Expect(slices.FromSeq(iterx.Flatten(slices.Seq([]iter.Seq[int]{seqB, emptySeq, seqB})))).To(Equal([]int{1, 2, 3, 1, 2, 3}))
+			})
+		})
+		Context("when stopping early", func() {
+			It("should yield the first elements only", func() {
+				Expect(slices.FromSeq(iterx.Take(iterx.Chain(seqB, naturals()), 5))).To(Equal([]int{1, 2, 3, 0, 1}))
+			})
+		})
+	})
+
+	Describe("tests for Window()", func() {
+		Context("with a sequence shorter than the window", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(iterx.Window(seqB, 4))).To(BeEmpty())
+			})
+		})
+		Context("with a more complex sequence", func() {
+			It("should yield the sliding windows", func() {
+				Expect(slices.FromSeq(iterx.Window(seqB, 2))).To(Equal([][]int{{1, 2}, {2, 3}}))
+			})
+		})
+		Context("with window size zero", func() {
+			It("should panic", func() {
+				Expect(func() { iterx.Window(seqB, 0) }).To(Panic())
+			})
+		})
+	})
+
+	Describe("tests for Chunk()", func() {
+		Context("with an empty sequence", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(iterx.Chunk(emptySeq, 2))).To(BeEmpty())
+			})
+		})
+		Context("with a more complex sequence", func() {
+			It("should yield the chunks", func() {
+				Expect(slices.FromSeq(iterx.Chunk(seqB, 2))).To(Equal([][]int{{1, 2}, {3}}))
+				Expect(slices.FromSeq(iterx.Chunk(seqB, 3))).To(Equal([][]int{{1, 2, 3}}))
+			})
+		})
+		Context("with chunk size zero", func() {
+			It("should panic", func() {
+				Expect(func() { iterx.Chunk(seqB, 0) }).To(Panic())
+			})
+		})
+	})
+
+	Describe("tests for Enumerate()", func() {
+		Context("with a more complex sequence", func() {
+			It("should yield the elements along with their index", func() {
+				var indices []int
+				var values []int
+				for i, x := range iterx.Enumerate(seqB) {
+					indices = append(indices, i)
+					values = append(values, x)
+				}
+				Expect(indices).To(Equal([]int{0, 1, 2}))
+				Expect(values).To(Equal([]int{1, 2, 3}))
+			})
+		})
+	})
+
+	Describe("tests for Reduce()", func() {
+		Context("with an empty sequence", func() {
+			It("should return the initial value", func() {
+				Expect(iterx.Reduce(emptySeq, 42, func(int, int) int { return 0 })).To(Equal(42))
+			})
+		})
+		Context("with a more complex sequence", func() {
+			It("should return the accumulated value", func() {
+				Expect(iterx.Reduce(seqD, 0, func(r int, x int) int { return r + x })).To(Equal(54))
+			})
+		})
+	})
+
+	Describe("tests for Any(), All(), None() and Count()", func() {
+		Context("with an empty sequence", func() {
+			It("should return the neutral results", func() {
+				Expect(iterx.Any(emptySeq, func(int) bool { return true })).To(BeFalse())
+				Expect(iterx.All(emptySeq, func(int) bool { return false })).To(BeTrue())
+				Expect(iterx.None(emptySeq, func(int) bool { return true })).To(BeTrue())
+				Expect(iterx.Count(emptySeq, func(int) bool { return true })).To(Equal(0))
+			})
+		})
+		Context("with an infinite sequence", func() {
+			It("should short-circuit", func() {
+				Expect(iterx.Any(naturals(), func(x int) bool { return x > 10 })).To(BeTrue())
+				Expect(iterx.All(naturals(), func(x int) bool { return x < 10 })).To(BeFalse())
+				Expect(iterx.None(naturals(), func(x int) bool { return x > 10 })).To(BeFalse())
+			})
+		})
+		Context("with a more complex sequence", func() {
+			It("should count the matching elements", func() {
+				Expect(iterx.Count(seqD, func(x int) bool { return x > 6 })).To(Equal(4))
+			})
+		})
+	})
+})
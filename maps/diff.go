@@ -0,0 +1,155 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sap/go-generics/internal/reflection"
+)
+
+// Change of a map value, as reported by Diff() and DiffBy().
+type Change[V any] struct {
+	Old V
+	New V
+}
+
+// Differences between two maps, as returned by Diff() and DiffBy().
+// Added contains the entries only present in the second map, Removed the entries only present in the first map,
+// and Changed the keys present in both maps, along with their differing values.
+type Delta[K comparable, V any] struct {
+	Added   map[K]V
+	Removed map[K]V
+	Changed map[K]Change[V]
+}
+
+// Error returned by ApplyDiff() if the delta does not match the map it is applied to.
+var ErrDeltaMismatch = errors.New("delta does not match map")
+
+// Compute differences between maps m and n, comparing values by given equality function.
+// The maps contained in the returned delta are never nil (but may be empty).
+func DiffBy[K comparable, V any](m map[K]V, n map[K]V, f func(V, V) bool) Delta[K, V] {
+	d := Delta[K, V]{
+		Added:   make(map[K]V),
+		Removed: make(map[K]V),
+		Changed: make(map[K]Change[V]),
+	}
+	for k, v := range m {
+		if w, ok := n[k]; !ok {
+			d.Removed[k] = v
+		} else if !f(v, w) {
+			d.Changed[k] = Change[V]{Old: v, New: w}
+		}
+	}
+	for k, w := range n {
+		if _, ok := m[k]; !ok {
+			d.Added[k] = w
+		}
+	}
+	return d
+}
+
+// Compute differences between maps m and n of comparable values, comparing values by the == operator.
+// The maps contained in the returned delta are never nil (but may be empty).
+func Diff[K comparable, V comparable](m map[K]V, n map[K]V) Delta[K, V] {
+	f := func(x V, y V) bool {
+		return x == y
+	}
+	return DiffBy(m, n, f)
+}
+
+// Apply delta to map m, returning a new map (the input map remains unchanged).
+// Returns an error (wrapping ErrDeltaMismatch) if an added key is already present in m, or if a removed or changed key
+// is missing in m; the old values recorded in the delta are not checked against m.
+func ApplyDiff[K comparable, V any](m map[K]V, d Delta[K, V]) (map[K]V, error) {
+	r := make(map[K]V, len(m)+len(d.Added))
+	for k, v := range m {
+		r[k] = v
+	}
+	for k := range d.Removed {
+		if _, ok := r[k]; !ok {
+			return nil, fmt.Errorf("%w: removed key %v not found", ErrDeltaMismatch, k)
+		}
+		delete(r, k)
+	}
+	for k, c := range d.Changed {
+		if _, ok := r[k]; !ok {
+			return nil, fmt.Errorf("%w: changed key %v not found", ErrDeltaMismatch, k)
+		}
+		r[k] = c.New
+	}
+	for k, v := range d.Added {
+		if _, ok := m[k]; ok {
+			return nil, fmt.Errorf("%w: added key %v already exists", ErrDeltaMismatch, k)
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+// Check if delta contains no differences.
+func (d Delta[K, V]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Format delta as human-readable report, with one line per key, sorted by key; lines have the form "+ key: value"
+// (added), "- key: value" (removed), or "~ key: old -> new" (changed).
+// Keys and values are formatted with %v; keys of non-orderable types are sorted by their formatted representation.
+// If the delta contains no differences, an empty string is returned.
+func (d Delta[K, V]) String() string {
+	keys := make([]K, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	keys = append(keys, Keys(d.Added)...)
+	keys = append(keys, Keys(d.Removed)...)
+	keys = append(keys, Keys(d.Changed)...)
+	sortKeys(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		if v, ok := d.Added[k]; ok {
+			fmt.Fprintf(&b, "+ %v: %v\n", k, v)
+		}
+		if v, ok := d.Removed[k]; ok {
+			fmt.Fprintf(&b, "- %v: %v\n", k, v)
+		}
+		if c, ok := d.Changed[k]; ok {
+			fmt.Fprintf(&b, "~ %v: %v -> %v\n", k, c.Old, c.New)
+		}
+	}
+	return b.String()
+}
+
+// Sort keys deterministically; orderable kinds are sorted naturally, other keys by their formatted representation.
+func sortKeys[K comparable](keys []K) {
+	if reflection.Sort(keys) {
+		return
+	}
+	s := make([]string, len(keys))
+	for i, k := range keys {
+		s[i] = fmt.Sprint(k)
+	}
+	sort.Sort(byStrings[K]{keys: keys, s: s})
+}
+
+// Helper to sort keys by (precomputed) strings.
+type byStrings[K any] struct {
+	keys []K
+	s    []string
+}
+
+func (b byStrings[K]) Len() int {
+	return len(b.s)
+}
+
+func (b byStrings[K]) Less(i, j int) bool {
+	return b.s[i] < b.s[j]
+}
+
+func (b byStrings[K]) Swap(i, j int) {
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+	b.s[i], b.s[j] = b.s[j], b.s[i]
+}
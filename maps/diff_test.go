@@ -0,0 +1,106 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+	"github.com/sap/go-generics/pairs"
+)
+
+var _ = Describe("maps (diff)", func() {
+	var nilMap map[string]string
+	var mapA map[string]string
+	var mapB map[string]string
+
+	BeforeEach(func() {
+		mapA = map[string]string{"app": "web", "tier": "frontend", "team": "a", "zone": "eu"}
+		mapB = map[string]string{"app": "web", "tier": "backend", "team": "b", "owner": "x"}
+	})
+
+	Describe("tests for Diff()", func() {
+		Context("with nil maps", func() {
+			It("should return an empty delta", func() {
+				d := maps.Diff(nilMap, nilMap)
+				Expect(d.IsEmpty()).To(BeTrue())
+				Expect(d.Added).NotTo(BeNil())
+				Expect(d.Removed).NotTo(BeNil())
+				Expect(d.Changed).NotTo(BeNil())
+				Expect(d.String()).To(Equal(""))
+			})
+		})
+		Context("with equal maps", func() {
+			It("should return an empty delta", func() {
+				Expect(maps.Diff(mapA, maps.Select(mapA, func(string, string) bool { return true })).IsEmpty()).To(BeTrue())
+			})
+		})
+		Context("with different maps", func() {
+			It("should return added, removed and changed entries", func() {
+				d := maps.Diff(mapA, mapB)
+				Expect(d.IsEmpty()).To(BeFalse())
+				Expect(d.Added).To(Equal(map[string]string{"owner": "x"}))
+				Expect(d.Removed).To(Equal(map[string]string{"zone": "eu"}))
+				Expect(d.Changed).To(Equal(map[string]maps.Change[string]{"tier": {Old: "frontend", New: "backend"}, "team": {Old: "a", New: "b"}}))
+			})
+		})
+	})
+
+	Describe("tests for DiffBy()", func() {
+		It("should compare values by the given equality function", func() {
+			d := maps.DiffBy(mapA, map[string]string{"app": "WEB", "tier": "Frontend", "team": "A", "zone": "us"}, strings.EqualFold)
+			Expect(d.Added).To(BeEmpty())
+			Expect(d.Removed).To(BeEmpty())
+			Expect(d.Changed).To(Equal(map[string]maps.Change[string]{"zone": {Old: "eu", New: "us"}}))
+		})
+	})
+
+	Describe("tests for ApplyDiff()", func() {
+		Context("with a matching delta", func() {
+			It("should transform the first map into the second, and leave the input unchanged", func() {
+				r, err := maps.ApplyDiff(mapA, maps.Diff(mapA, mapB))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(mapB))
+				Expect(mapA).To(HaveLen(4))
+				Expect(mapA["zone"]).To(Equal("eu"))
+				r, err = maps.ApplyDiff(nilMap, maps.Diff(nilMap, mapB))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(mapB))
+			})
+		})
+		Context("with a non-matching delta", func() {
+			It("should fail", func() {
+				_, err := maps.ApplyDiff(mapB, maps.Diff(mapA, mapB))
+				Expect(err).To(MatchError(maps.ErrDeltaMismatch))
+				_, err = maps.ApplyDiff(nilMap, maps.Delta[string, string]{Changed: map[string]maps.Change[string]{"a": {}}})
+				Expect(err).To(MatchError("delta does not match map: changed key a not found"))
+				_, err = maps.ApplyDiff(mapA, maps.Delta[string, string]{Added: map[string]string{"app": "x"}})
+				Expect(err).To(MatchError("delta does not match map: added key app already exists"))
+			})
+		})
+	})
+
+	Describe("tests for Delta.String()", func() {
+		It("should return a sorted report", func() {
+			Expect(maps.Diff(mapA, mapB).String()).To(Equal(strings.Join([]string{
+				"+ owner: x",
+				"~ team: a -> b",
+				"~ tier: frontend -> backend",
+				"- zone: eu",
+				"",
+			}, "\n")))
+		})
+		It("should sort numeric keys naturally", func() {
+			Expect(maps.Diff(map[int]int{10: 1, 9: 1}, map[int]int{2: 1}).String()).To(Equal("+ 2: 1\n- 9: 1\n- 10: 1\n"))
+		})
+		It("should sort other keys by their string representation", func() {
+			d := maps.Diff(map[pairs.Pair[int, int]]bool{pairs.Make(2, 1): true}, map[pairs.Pair[int, int]]bool{pairs.Make(1, 2): true})
+			Expect(d.String()).To(Equal("+ (1, 2): true\n- (2, 1): true\n"))
+		})
+	})
+})
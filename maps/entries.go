@@ -0,0 +1,36 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import "github.com/sap/go-generics/pairs"
+
+// Get entries of map, as key-value pairs.
+// If the input is nil, it will return a nil slice; otherwise, if the input is empty, it will return an empty slice.
+// Note that there is no guarantee about the order of the returned entries.
+func Entries[K comparable, V any](m map[K]V) []pairs.Pair[K, V] {
+	if m == nil {
+		return nil
+	}
+	entries := make([]pairs.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, pairs.Pair[K, V]{X: k, Y: v})
+	}
+	return entries
+}
+
+// Create map from key-value pairs.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty map.
+// If there are duplicate keys, the according latter values win.
+func FromEntries[K comparable, V any](entries []pairs.Pair[K, V]) map[K]V {
+	if entries == nil {
+		return nil
+	}
+	m := make(map[K]V, len(entries))
+	for _, e := range entries {
+		m[e.X] = e.Y
+	}
+	return m
+}
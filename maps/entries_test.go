@@ -0,0 +1,57 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+	"github.com/sap/go-generics/pairs"
+)
+
+var _ = Describe("maps (entries)", func() {
+	Describe("tests for Entries()", func() {
+		Context("with a nil map", func() {
+			It("should return nil", func() {
+				Expect(maps.Entries[string, int](nil)).To(BeNil())
+			})
+		})
+		Context("with an empty map", func() {
+			It("should return an empty slice", func() {
+				Expect(maps.Entries(map[string]int{})).To(Equal([]pairs.Pair[string, int]{}))
+			})
+		})
+		Context("with a non-empty map", func() {
+			It("should return the entries", func() {
+				Expect(maps.Entries(map[string]int{"a": 1, "b": 2})).To(ConsistOf(pairs.Pair[string, int]{X: "a", Y: 1}, pairs.Pair[string, int]{X: "b", Y: 2}))
+			})
+		})
+	})
+
+	Describe("tests for FromEntries()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(maps.FromEntries[string, int](nil)).To(BeNil())
+			})
+		})
+		Context("with an empty slice", func() {
+			It("should return an empty map", func() {
+				Expect(maps.FromEntries([]pairs.Pair[string, int]{})).To(Equal(map[string]int{}))
+			})
+		})
+		Context("with duplicate keys", func() {
+			It("should let latter values win", func() {
+				Expect(maps.FromEntries([]pairs.Pair[string, int]{{X: "a", Y: 1}, {X: "b", Y: 2}, {X: "a", Y: 3}})).To(Equal(map[string]int{"a": 3, "b": 2}))
+			})
+		})
+		Context("with entries of a map", func() {
+			It("should restore the map", func() {
+				m := map[string]int{"a": 1, "b": 2}
+				Expect(maps.FromEntries(maps.Entries(m))).To(Equal(m))
+			})
+		})
+	})
+})
@@ -0,0 +1,120 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import (
+	"fmt"
+
+	"github.com/sap/go-generics/slices"
+)
+
+// Error returned by the error-returning variants of the functions in this package (such as CollectE()),
+// wrapping the error returned by the callback function, along with the key of the failing entry.
+type KeyError[K comparable] struct {
+	Key K
+	Err error
+}
+
+func (e *KeyError[K]) Error() string {
+	return fmt.Sprintf("key %v: %s", e.Key, e.Err)
+}
+
+func (e *KeyError[K]) Unwrap() error {
+	return e.Err
+}
+
+// Variant of Collect() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into a *KeyError); in that case, the returned map is nil.
+// Note that, since maps are unordered, it is not predictable which error is returned if f fails for several entries.
+func CollectE[K comparable, V any, W any](m map[K]V, f func(V) (W, error)) (map[K]W, error) {
+	if m == nil {
+		return nil, nil
+	}
+	n := make(map[K]W)
+	for k, v := range m {
+		w, err := f(v)
+		if err != nil {
+			return nil, &KeyError[K]{Key: k, Err: err}
+		}
+		n[k] = w
+	}
+	return n, nil
+}
+
+// Variant of CollectSlice() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into a *slices.IndexError); in that case, the returned map is nil.
+func CollectSliceE[T any, K comparable, V any](s []T, f func(T) (K, V, error)) (map[K]V, error) {
+	if s == nil {
+		return nil, nil
+	}
+	m := make(map[K]V)
+	for i, x := range s {
+		k, v, err := f(x)
+		if err != nil {
+			return nil, &slices.IndexError{Index: i, Err: err}
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// Variant of Select() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into a *KeyError); in that case, the returned map is nil.
+// Note that, since maps are unordered, it is not predictable which error is returned if f fails for several entries.
+func SelectE[K comparable, V any](m map[K]V, f func(K, V) (bool, error)) (map[K]V, error) {
+	if m == nil {
+		return nil, nil
+	}
+	n := make(map[K]V)
+	for k, v := range m {
+		ok, err := f(k, v)
+		if err != nil {
+			return nil, &KeyError[K]{Key: k, Err: err}
+		}
+		if ok {
+			n[k] = v
+		}
+	}
+	return n, nil
+}
+
+// Variant of EqualBy() for equality functions which may fail.
+// Stops at the first error, and returns it (wrapped into a *KeyError); in that case, false is returned.
+func EqualByE[K comparable, V any, W any](m map[K]V, n map[K]W, f func(V, W) (bool, error)) (bool, error) {
+	if len(m) != len(n) {
+		return false, nil
+	}
+	for k, v := range m {
+		w, ok := n[k]
+		if !ok {
+			return false, nil
+		}
+		ok, err := f(v, w)
+		if err != nil {
+			return false, &KeyError[K]{Key: k, Err: err}
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Variant of Count() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into a *KeyError); in that case, zero is returned.
+func CountE[K comparable, V any](m map[K]V, f func(K, V) (bool, error)) (int, error) {
+	c := 0
+	for k, v := range m {
+		ok, err := f(k, v)
+		if err != nil {
+			return 0, &KeyError[K]{Key: k, Err: err}
+		}
+		if ok {
+			c++
+		}
+	}
+	return c, nil
+}
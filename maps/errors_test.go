@@ -0,0 +1,150 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	"errors"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("maps (error-returning variants)", func() {
+	var errFailed error
+	var nilMap map[int]string
+	var emptyMap map[int]string
+	var mapB map[int]string
+
+	expectKeyError := func(err error, k int) {
+		var keyError *maps.KeyError[int]
+		Expect(errors.As(err, &keyError)).To(BeTrue())
+		Expect(keyError.Key).To(Equal(k))
+		Expect(err).To(MatchError(errFailed))
+	}
+
+	BeforeEach(func() {
+		errFailed = errors.New("failed")
+		nilMap = nil
+		emptyMap = map[int]string{}
+		mapB = map[int]string{1: "u", 2: "v", 3: "w", 4: "w"}
+	})
+
+	Describe("tests for CollectE()", func() {
+		Context("with a nil map", func() {
+			It("should return nil", func() {
+				Expect(maps.CollectE(nilMap, func(string) (string, error) { return "", nil })).To(Equal(nilMap))
+			})
+		})
+		Context("with an empty map", func() {
+			It("should return an empty map", func() {
+				Expect(maps.CollectE(emptyMap, func(string) (string, error) { return "", nil })).To(Equal(emptyMap))
+			})
+		})
+		Context("with a succeeding function", func() {
+			It("should return the mapped values", func() {
+				Expect(maps.CollectE(map[int]string{1: "2", 2: "3"}, strconv.Atoi)).To(Equal(map[int]int{1: 2, 2: 3}))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				r, err := maps.CollectE(map[int]string{1: "2", 2: "x"}, strconv.Atoi)
+				Expect(r).To(BeNil())
+				var keyError *maps.KeyError[int]
+				Expect(errors.As(err, &keyError)).To(BeTrue())
+				Expect(keyError.Key).To(Equal(2))
+				Expect(errors.Is(err, strconv.ErrSyntax)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("tests for CollectSliceE()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(maps.CollectSliceE([]string(nil), func(string) (int, string, error) { return 0, "", nil })).To(Equal(nilMap))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error, wrapped with the index", func() {
+				f := func(s string) (int, string, error) {
+					n, err := strconv.Atoi(s)
+					return n, s, err
+				}
+				Expect(maps.CollectSliceE([]string{"1", "2"}, f)).To(Equal(map[int]string{1: "1", 2: "2"}))
+				_, err := maps.CollectSliceE([]string{"1", "x"}, f)
+				var indexError *slices.IndexError
+				Expect(errors.As(err, &indexError)).To(BeTrue())
+				Expect(indexError.Index).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("tests for SelectE()", func() {
+		Context("with a nil map", func() {
+			It("should return nil", func() {
+				Expect(maps.SelectE(nilMap, func(int, string) (bool, error) { return true, nil })).To(Equal(nilMap))
+			})
+		})
+		Context("with a succeeding function", func() {
+			It("should match Select()", func() {
+				f := func(k int, v string) (bool, error) { return k <= 1 || v == "w", nil }
+				Expect(maps.SelectE(mapB, f)).To(Equal(map[int]string{1: "u", 3: "w", 4: "w"}))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				r, err := maps.SelectE(mapB, func(k int, _ string) (bool, error) {
+					if k == 3 {
+						return false, errFailed
+					}
+					return true, nil
+				})
+				Expect(r).To(BeNil())
+				expectKeyError(err, 3)
+			})
+		})
+	})
+
+	Describe("tests for EqualByE()", func() {
+		Context("with nil/empty maps", func() {
+			It("should return true", func() {
+				Expect(maps.EqualByE(nilMap, emptyMap, func(string, string) (bool, error) { return false, errFailed })).To(BeTrue())
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				f := func(x, y string) (bool, error) {
+					if x == "v" {
+						return false, errFailed
+					}
+					return x == y, nil
+				}
+				Expect(maps.EqualByE(map[int]string{1: "u"}, map[int]string{1: "u"}, f)).To(BeTrue())
+				_, err := maps.EqualByE(mapB, mapB, f)
+				expectKeyError(err, 2)
+			})
+		})
+	})
+
+	Describe("tests for CountE()", func() {
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				f := func(k int, v string) (bool, error) {
+					if k == 4 {
+						return false, errFailed
+					}
+					return v == "w", nil
+				}
+				Expect(maps.CountE(map[int]string{1: "w", 2: "w", 3: "x"}, f)).To(Equal(2))
+				c, err := maps.CountE(mapB, f)
+				Expect(c).To(Equal(0))
+				expectKeyError(err, 4)
+			})
+		})
+	})
+})
@@ -0,0 +1,274 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sap/go-generics/slices"
+)
+
+// Style of slice indices in keys produced by Flatten() (and accepted by Unflatten()).
+type IndexStyle int
+
+const (
+	// Slice indices are joined like map keys, such as a.0.b.
+	IndexSeparated IndexStyle = iota
+	// Slice indices are appended in brackets, such as a[0].b.
+	IndexBrackets
+)
+
+// Options for Flatten() and Unflatten().
+type FlattenOptions struct {
+	// Separator between joined keys; empty means ".".
+	Separator string
+	// Style of slice indices.
+	IndexStyle IndexStyle
+}
+
+// Error returned by Flatten() and Unflatten() if different keys would be mapped to the same key,
+// or if a key would refer to a value as well as to values nested into it.
+var ErrKeyCollision = errors.New("key collision")
+
+// Flatten nested map into a map with joined keys, such as a.b.0.c (according to given options).
+// Values of type map[string]any and []any are flattened recursively, unless they are empty (in which case they are
+// kept as they are); all other values are kept as they are.
+// Returns an error (wrapping ErrKeyCollision) if two different values would be mapped to the same key, or if a key
+// would refer to a value as well as to values nested into it (for example, because a key contains the separator);
+// more generally, an error is returned if the result would be rejected by Unflatten() (with the same options).
+// In that case, the returned map is nil.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty map.
+func Flatten(m map[string]any, options FlattenOptions) (map[string]any, error) {
+	if m == nil {
+		return nil, nil
+	}
+	r := make(map[string]any)
+	if err := flatten(r, "", true, m, options.separator(), options.IndexStyle); err != nil {
+		return nil, err
+	}
+	if _, err := Unflatten(r, options); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Unflatten map with joined keys into a nested map, reversing Flatten() (with the same options).
+// With IndexBrackets, keys such as a[0] create slices; indices must be contiguous, starting from 0.
+// With IndexSeparated, indices cannot be distinguished from map keys; therefore, nested maps whose keys are exactly
+// 0, 1, ..., n-1 are converted into slices.
+// Returns an error (wrapping ErrKeyCollision) if a key refers to a value as well as to values nested into it
+// (such as a and a.b), or if a value would have to be a map as well as a slice; in that case, the returned map is nil.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty map.
+func Unflatten(m map[string]any, options FlattenOptions) (map[string]any, error) {
+	if m == nil {
+		return nil, nil
+	}
+	root := &flatNode{}
+	separator := options.separator()
+	for _, k := range slices.Sort(Keys(m)) {
+		n := root
+		for _, t := range splitFlatKey(k, separator, options.IndexStyle) {
+			var err error
+			if n, err = n.child(t); err != nil {
+				return nil, fmt.Errorf("%w at key %q", err, k)
+			}
+		}
+		if n.keys != nil || n.indices != nil {
+			return nil, fmt.Errorf("%w at key %q: value conflicts with nested values", ErrKeyCollision, k)
+		}
+		n.value, n.leaf = m[k], true
+	}
+	if root.indices != nil {
+		return nil, fmt.Errorf("%w: top-level keys must not be indices", ErrKeyCollision)
+	}
+	r := make(map[string]any)
+	for k, c := range root.keys {
+		v, err := c.build(options.IndexStyle)
+		if err != nil {
+			return nil, err
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+func (o FlattenOptions) separator() string {
+	if o.Separator == "" {
+		return "."
+	}
+	return o.Separator
+}
+
+// Flatten value v into r, using given key prefix (unless top is true, i.e. v is the top-level map).
+func flatten(r map[string]any, prefix string, top bool, v any, separator string, style IndexStyle) error {
+	join := func(k string) string {
+		if top {
+			return k
+		}
+		return prefix + separator + k
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) > 0 || top {
+			for _, k := range slices.Sort(Keys(v)) {
+				if err := flatten(r, join(k), false, v[k], separator, style); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []any:
+		if len(v) > 0 {
+			for i, w := range v {
+				k := join(strconv.Itoa(i))
+				if style == IndexBrackets {
+					k = prefix + "[" + strconv.Itoa(i) + "]"
+				}
+				if err := flatten(r, k, false, w, separator, style); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	if _, ok := r[prefix]; ok {
+		return fmt.Errorf("%w at key %q", ErrKeyCollision, prefix)
+	}
+	r[prefix] = v
+	return nil
+}
+
+// Token of a flattened key; either a map key, or (if index is non-negative) a slice index.
+type flatToken struct {
+	key   string
+	index int
+}
+
+// Split flattened key into tokens.
+func splitFlatKey(k string, separator string, style IndexStyle) []flatToken {
+	var tokens []flatToken
+	for _, s := range strings.Split(k, separator) {
+		if style != IndexBrackets {
+			tokens = append(tokens, flatToken{key: s, index: -1})
+			continue
+		}
+		// split off trailing bracketed indices, such as a[0][1]
+		var indices []int
+		for strings.HasSuffix(s, "]") {
+			j := strings.LastIndexByte(s, '[')
+			if j < 0 {
+				break
+			}
+			i, ok := parseFlatIndex(s[j+1 : len(s)-1])
+			if !ok {
+				break
+			}
+			indices = append(indices, i)
+			s = s[:j]
+		}
+		if s != "" || len(indices) == 0 {
+			tokens = append(tokens, flatToken{key: s, index: -1})
+		}
+		for l := len(indices) - 1; l >= 0; l-- {
+			tokens = append(tokens, flatToken{index: indices[l]})
+		}
+	}
+	return tokens
+}
+
+// Parse slice index (a decimal number without sign or leading zeros).
+func parseFlatIndex(s string) (int, bool) {
+	if s == "" || (s[0] == '0' && len(s) > 1) || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+	i, err := strconv.Atoi(s)
+	return i, err == nil
+}
+
+// Node of the tree built by Unflatten().
+type flatNode struct {
+	value   any
+	leaf    bool
+	keys    map[string]*flatNode
+	indices map[int]*flatNode
+}
+
+// Get (or create) child node for given token.
+func (n *flatNode) child(t flatToken) (*flatNode, error) {
+	if n.leaf {
+		return nil, fmt.Errorf("%w: nested values conflict with value", ErrKeyCollision)
+	}
+	if t.index >= 0 {
+		if n.keys != nil {
+			return nil, fmt.Errorf("%w: index conflicts with map keys", ErrKeyCollision)
+		}
+		if n.indices == nil {
+			n.indices = make(map[int]*flatNode)
+		}
+		c, ok := n.indices[t.index]
+		if !ok {
+			c = &flatNode{}
+			n.indices[t.index] = c
+		}
+		return c, nil
+	}
+	if n.indices != nil {
+		return nil, fmt.Errorf("%w: map key conflicts with indices", ErrKeyCollision)
+	}
+	if n.keys == nil {
+		n.keys = make(map[string]*flatNode)
+	}
+	c, ok := n.keys[t.key]
+	if !ok {
+		c = &flatNode{}
+		n.keys[t.key] = c
+	}
+	return c, nil
+}
+
+// Build value from node.
+func (n *flatNode) build(style IndexStyle) (any, error) {
+	if n.leaf {
+		return n.value, nil
+	}
+	if n.indices != nil {
+		s := make([]any, len(n.indices))
+		for i, c := range n.indices {
+			if i >= len(s) {
+				return nil, fmt.Errorf("indices must be contiguous, starting from 0 (found index %d of %d)", i, len(s))
+			}
+			v, err := c.build(style)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = v
+		}
+		return s, nil
+	}
+	m := make(map[string]any, len(n.keys))
+	for k, c := range n.keys {
+		v, err := c.build(style)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = v
+	}
+	if style == IndexSeparated {
+		s := make([]any, len(m))
+		for k, v := range m {
+			i, ok := parseFlatIndex(k)
+			if !ok || i >= len(s) {
+				return m, nil
+			}
+			s[i] = v
+		}
+		return s, nil
+	}
+	return m, nil
+}
@@ -0,0 +1,163 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+)
+
+var _ = Describe("maps (flatten)", func() {
+	var nested map[string]any
+
+	BeforeEach(func() {
+		nested = map[string]any{
+			"name": "app",
+			"server": map[string]any{
+				"port": 80,
+				"tls":  map[string]any{"enabled": true},
+			},
+			"hosts": []any{"a", map[string]any{"name": "b"}, []any{1, 2}},
+			"empty": map[string]any{},
+			"none":  []any{},
+		}
+	})
+
+	Describe("tests for Flatten()", func() {
+		Context("with a nil map", func() {
+			It("should return nil", func() {
+				r, err := maps.Flatten(nil, maps.FlattenOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(BeNil())
+			})
+		})
+		Context("with default options", func() {
+			It("should join keys by dots", func() {
+				r, err := maps.Flatten(nested, maps.FlattenOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]any{
+					"name":               "app",
+					"server.port":        80,
+					"server.tls.enabled": true,
+					"hosts.0":            "a",
+					"hosts.1.name":       "b",
+					"hosts.2.0":          1,
+					"hosts.2.1":          2,
+					"empty":              map[string]any{},
+					"none":               []any{},
+				}))
+			})
+		})
+		Context("with custom separator and bracket indices", func() {
+			It("should join keys accordingly", func() {
+				r, err := maps.Flatten(nested, maps.FlattenOptions{Separator: "__", IndexStyle: maps.IndexBrackets})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]any{
+					"name":                 "app",
+					"server__port":         80,
+					"server__tls__enabled": true,
+					"hosts[0]":             "a",
+					"hosts[1]__name":       "b",
+					"hosts[2][0]":          1,
+					"hosts[2][1]":          2,
+					"empty":                map[string]any{},
+					"none":                 []any{},
+				}))
+			})
+		})
+		Context("with colliding keys", func() {
+			It("should fail", func() {
+				r, err := maps.Flatten(map[string]any{"a.b": 1, "a": map[string]any{"b": 2}}, maps.FlattenOptions{})
+				Expect(err).To(MatchError(maps.ErrKeyCollision))
+				Expect(err).To(MatchError(`key collision at key "a.b"`))
+				Expect(r).To(BeNil())
+			})
+		})
+		Context("with keys colliding with nested keys", func() {
+			It("should fail instead of producing a result rejected by Unflatten()", func() {
+				m := map[string]any{"a": map[string]any{"b": 1}, "a.b.c": 2}
+				r, err := maps.Flatten(m, maps.FlattenOptions{})
+				Expect(err).To(MatchError(maps.ErrKeyCollision))
+				Expect(err).To(MatchError(`key collision: nested values conflict with value at key "a.b.c"`))
+				Expect(r).To(BeNil())
+				r, err = maps.Flatten(map[string]any{"a": []any{1}, "a[0]x": 2}, maps.FlattenOptions{IndexStyle: maps.IndexBrackets})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = maps.Flatten(map[string]any{"a": []any{1}, "a[0]": map[string]any{"x": 2}}, maps.FlattenOptions{IndexStyle: maps.IndexBrackets})
+				Expect(err).To(MatchError(maps.ErrKeyCollision))
+				_, err = maps.Flatten(map[string]any{"[0]": 1}, maps.FlattenOptions{IndexStyle: maps.IndexBrackets})
+				Expect(err).To(MatchError(maps.ErrKeyCollision))
+			})
+		})
+		Context("with selecting by prefix", func() {
+			It("should compose with Select()", func() {
+				r, err := maps.Flatten(nested, maps.FlattenOptions{Separator: "_"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(maps.Select(r, func(k string, _ any) bool { return strings.HasPrefix(k, "server_") })).To(Equal(map[string]any{
+					"server_port":        80,
+					"server_tls_enabled": true,
+				}))
+			})
+		})
+	})
+
+	Describe("tests for Unflatten()", func() {
+		Context("with a nil map", func() {
+			It("should return nil", func() {
+				r, err := maps.Unflatten(nil, maps.FlattenOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(BeNil())
+			})
+		})
+		Context("with flattened maps", func() {
+			It("should restore the nested map", func() {
+				for _, options := range []maps.FlattenOptions{{}, {Separator: "/", IndexStyle: maps.IndexBrackets}} {
+					r, err := maps.Flatten(nested, options)
+					Expect(err).NotTo(HaveOccurred())
+					r, err = maps.Unflatten(r, options)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(r).To(Equal(nested))
+				}
+			})
+		})
+		Context("with separated indices", func() {
+			It("should only create slices for contiguous indices", func() {
+				r, err := maps.Unflatten(map[string]any{"a.0": 1, "a.1": 2, "b.1": 3, "c.01": 4, "c.0": 5}, maps.FlattenOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]any{"a": []any{1, 2}, "b": map[string]any{"1": 3}, "c": map[string]any{"0": 5, "01": 4}}))
+			})
+		})
+		Context("with bracket indices", func() {
+			It("should treat non-numeric brackets as part of the key", func() {
+				r, err := maps.Unflatten(map[string]any{"a[x]": 1, "b[0][1]": 2, "b[0][0]": 3}, maps.FlattenOptions{IndexStyle: maps.IndexBrackets})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]any{"a[x]": 1, "b": []any{[]any{3, 2}}}))
+			})
+			It("should fail on non-contiguous indices", func() {
+				_, err := maps.Unflatten(map[string]any{"a[0]": 1, "a[2]": 2}, maps.FlattenOptions{IndexStyle: maps.IndexBrackets})
+				Expect(err).To(MatchError("indices must be contiguous, starting from 0 (found index 2 of 2)"))
+			})
+		})
+		Context("with colliding keys", func() {
+			It("should fail", func() {
+				for _, m := range []map[string]any{
+					{"a": 1, "a.b": 2},
+					{"a.b.c": 1, "a.b": 2},
+				} {
+					r, err := maps.Unflatten(m, maps.FlattenOptions{})
+					Expect(err).To(MatchError(maps.ErrKeyCollision))
+					Expect(r).To(BeNil())
+				}
+				_, err := maps.Unflatten(map[string]any{"a[0]": 1, "a.b": 2}, maps.FlattenOptions{IndexStyle: maps.IndexBrackets})
+				Expect(err).To(MatchError(`key collision: index conflicts with map keys at key "a[0]"`))
+				_, err = maps.Unflatten(map[string]any{"[0]": 1}, maps.FlattenOptions{IndexStyle: maps.IndexBrackets})
+				Expect(err).To(MatchError(maps.ErrKeyCollision))
+			})
+		})
+	})
+})
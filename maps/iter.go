@@ -0,0 +1,132 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import (
+	"iter"
+
+	"github.com/sap/go-generics/slices"
+)
+
+// Get all entries of map as sequence.
+// If the input is nil or empty, the returned sequence will not yield any entries.
+// Note that there is no guarantee about the order of the yielded entries.
+func Seq[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Build map from sequence.
+// If the sequence does not yield any entries, an empty (non-nil) map will be returned.
+// Note that a sequence does not tell whether it was produced from a nil or an empty input; therefore, for example,
+// FromSeq(SelectSeq(nil, f)) returns an empty map, whereas Select(nil, f) returns nil.
+// If the sequence yields duplicate keys, the according latter values win.
+func FromSeq[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	m := make(map[K]V)
+	for k, v := range seq {
+		m[k] = v
+	}
+	return m
+}
+
+// Get all entries of map as sequence, ordered by key (ascending).
+// If the input is nil or empty, the returned sequence will not yield any entries.
+// Note that the keys are collected and sorted on each iteration; the values are read from the map
+// at the time they are yielded.
+func Sorted[K slices.Orderable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range slices.Sort(Keys(m)) {
+			v, ok := m[k]
+			if !ok {
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of Keys().
+// Note that there is no guarantee about the order of the yielded keys.
+func KeysSeq[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of Values().
+// Note that there is no guarantee about the order of the yielded values.
+func ValuesSeq[K comparable, V any](m map[K]V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of Collect().
+// The returned sequence yields the keys of the map, along with the values mapped through the provided function f.
+// Note that f is evaluated on demand, that is each time the sequence is iterated.
+func CollectSeq[K comparable, V any, W any](m map[K]V, f func(V) W) iter.Seq2[K, W] {
+	return func(yield func(K, W) bool) {
+		for k, v := range m {
+			if !yield(k, f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of CollectSlice().
+// The returned sequence yields the keys and values, as mapped through the provided function f.
+// Other than CollectSlice(), the sequence may yield duplicate keys (if f produces such).
+func CollectSliceSeq[T any, K comparable, V any](s []T, f func(T) (K, V)) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, x := range s {
+			if !yield(f(x)) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of Select().
+// The returned sequence yields the keys and according values, for which the provided function f evaluates to true.
+// Note that f is evaluated on demand, that is each time the sequence is iterated.
+func SelectSeq[K comparable, V any](m map[K]V, f func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if f(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of SelectByKeys().
+// The returned sequence yields those of the given keys (and according values) which exist in the map,
+// in the order of the given keys.
+func SelectByKeysSeq[K comparable, V any](m map[K]V, keys ...K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range keys {
+			if v, ok := m[k]; ok && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+)
+
+var _ = Describe("maps (iterators)", func() {
+	var nilMap map[int]string
+	var emptyMap map[int]string
+	var mapB map[int]string
+	var mapC map[int]float64
+	var sliceA []float64
+
+	BeforeEach(func() {
+		nilMap = nil
+		emptyMap = map[int]string{}
+		mapB = map[int]string{1: "u", 2: "v", 3: "w", 4: "w"}
+		mapC = map[int]float64{1: 2.1, 2: 3.14}
+		sliceA = []float64{1.52, 2.0, 3.88, 3.99}
+	})
+
+	Describe("tests for Seq() and FromSeq()", func() {
+		Context("with a nil map", func() {
+			It("should return an empty map", func() {
+				Expect(maps.FromSeq(maps.Seq(nilMap))).To(Equal(emptyMap))
+			})
+		})
+		Context("with a more complex map", func() {
+			It("should return the map unchanged", func() {
+				Expect(maps.FromSeq(maps.Seq(mapB))).To(Equal(mapB))
+			})
+		})
+	})
+
+	Describe("tests for Sorted()", func() {
+		Context("with a nil map", func() {
+			It("should yield nothing", func() {
+				for range maps.Sorted(nilMap) {
+					Fail("unexpected entry")
+				}
+			})
+		})
+		Context("with a more complex map", func() {
+			It("should yield the entries ordered by key", func() {
+				var keys []int
+				var values []string
+				for k, v := range maps.Sorted(map[int]string{4: "w", 2: "v", 3: "w", 1: "u"}) {
+					keys = append(keys, k)
+					values = append(values, v)
+				}
+				Expect(keys).To(Equal([]int{1, 2, 3, 4}))
+				Expect(values).To(Equal([]string{"u", "v", "w", "w"}))
+			})
+		})
+		Context("when stopping early", func() {
+			It("should yield the first entries only", func() {
+				var keys []int
+				for k := range maps.Sorted(mapB) {
+					if k == 3 {
+						break
+					}
+					keys = append(keys, k)
+				}
+				Expect(keys).To(Equal([]int{1, 2}))
+			})
+		})
+	})
+
+	Describe("tests for KeysSeq() and ValuesSeq()", func() {
+		Context("with a nil map", func() {
+			It("should yield nothing", func() {
+				for range maps.KeysSeq(nilMap) {
+					Fail("unexpected key")
+				}
+				for range maps.ValuesSeq(nilMap) {
+					Fail("unexpected value")
+				}
+			})
+		})
+		Context("with a more complex map", func() {
+			It("should match Keys() and Values()", func() {
+				var keys []int
+				for k := range maps.KeysSeq(mapB) {
+					keys = append(keys, k)
+				}
+				var values []string
+				for v := range maps.ValuesSeq(mapB) {
+					values = append(values, v)
+				}
+				Expect(keys).To(ConsistOf(maps.Keys(mapB)))
+				Expect(values).To(ConsistOf(maps.Values(mapB)))
+			})
+		})
+	})
+
+	Describe("tests for CollectSeq()", func() {
+		Context("with a more complex map", func() {
+			It("should match Collect()", func() {
+				f := func(x float64) int {
+					return int(x)
+				}
+				Expect(maps.FromSeq(maps.CollectSeq(mapC, f))).To(Equal(maps.Collect(mapC, f)))
+			})
+		})
+	})
+
+	Describe("tests for CollectSliceSeq()", func() {
+		Context("with a more complex slice", func() {
+			It("should match CollectSlice()", func() {
+				f := func(x float64) (int, string) {
+					return int(x), strconv.FormatFloat(x, 'f', 1, 64)
+				}
+				Expect(maps.FromSeq(maps.CollectSliceSeq(sliceA, f))).To(Equal(maps.CollectSlice(sliceA, f)))
+			})
+		})
+	})
+
+	Describe("tests for SelectSeq()", func() {
+		Context("with a nil map", func() {
+			It("should be collected into an empty (non-nil) map, other than the nil result of Select()", func() {
+				f := func(int, string) bool { return true }
+				Expect(maps.Select(nilMap, f)).To(BeNil())
+				Expect(maps.FromSeq(maps.SelectSeq(nilMap, f))).NotTo(BeNil())
+				Expect(maps.FromSeq(maps.SelectSeq(nilMap, f))).To(Equal(maps.Select(emptyMap, f)))
+			})
+		})
+		Context("with a more complex map", func() {
+			It("should match Select()", func() {
+				f := func(k int, v string) bool {
+					return k <= 1 || v == "w"
+				}
+				Expect(maps.FromSeq(maps.SelectSeq(mapB, f))).To(Equal(maps.Select(mapB, f)))
+			})
+		})
+	})
+
+	Describe("tests for SelectByKeysSeq()", func() {
+		Context("with a more complex map", func() {
+			It("should yield the existing keys in the given order", func() {
+				var keys []int
+				for k := range maps.SelectByKeysSeq(mapB, 3, 5, 1) {
+					keys = append(keys, k)
+				}
+				Expect(keys).To(Equal([]int{3, 1}))
+			})
+		})
+	})
+})
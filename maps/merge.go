@@ -0,0 +1,239 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/sap/go-generics/slices"
+)
+
+// Conflict resolution strategy, as accepted by Merge() and DeepMerge().
+// The strategy is called for each key present in more than one of the merged maps, with the value merged so far (x),
+// and the value of the next map (y); it returns the resolved value, or an error.
+type MergeStrategy[K comparable, V any] func(k K, x V, y V) (V, error)
+
+// Error returned by ErrorOnConflict().
+var ErrConflict = errors.New("conflicting values")
+
+// Error returned by DeepMerge(), wrapping the error returned by the conflict resolution strategy,
+// along with the path of the affected key.
+type PathError struct {
+	Path []string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("path %s: %s", strings.Join(e.Path, "."), e.Err)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// Conflict, as reported by ThreeWayMerge().
+// Absent values are represented as nil.
+type Conflict struct {
+	Path   []string
+	Base   any
+	Ours   any
+	Theirs any
+}
+
+// Conflict resolution strategy letting the latter value win.
+func LastWins[K comparable, V any](k K, x V, y V) (V, error) {
+	return y, nil
+}
+
+// Conflict resolution strategy letting the former value win.
+func FirstWins[K comparable, V any](k K, x V, y V) (V, error) {
+	return x, nil
+}
+
+// Conflict resolution strategy failing with ErrConflict, unless the values are deeply equal (as for reflect.DeepEqual()).
+func ErrorOnConflict[K comparable, V any](k K, x V, y V) (V, error) {
+	if !reflect.DeepEqual(x, y) {
+		return x, ErrConflict
+	}
+	return x, nil
+}
+
+// Return conflict resolution strategy (for DeepMerge()) concatenating the values if both are of type []any,
+// and delegating to the given strategy otherwise.
+func AppendSlices(next MergeStrategy[string, any]) MergeStrategy[string, any] {
+	return func(k string, x any, y any) (any, error) {
+		s, ok := x.([]any)
+		t, ok2 := y.([]any)
+		if ok && ok2 {
+			return append(append(make([]any, 0, len(s)+len(t)), s...), t...), nil
+		}
+		return next(k, x, y)
+	}
+}
+
+// Merge maps into a new map (the input maps remain unchanged), resolving keys present in multiple maps
+// by the given strategy (such as LastWins, FirstWins, ErrorOnConflict, or a custom function).
+// Returns an error (wrapped into a *KeyError) if the strategy fails; in that case, the returned map is nil.
+// If all inputs are nil, it will return nil; otherwise, if the result is empty, it will return an empty map.
+func Merge[K comparable, V any](strategy MergeStrategy[K, V], m ...map[K]V) (map[K]V, error) {
+	if slices.All(m, func(m map[K]V) bool { return m == nil }) {
+		return nil, nil
+	}
+	r := make(map[K]V)
+	for _, m := range m {
+		for k, y := range m {
+			if x, ok := r[k]; ok {
+				v, err := strategy(k, x, y)
+				if err != nil {
+					return nil, &KeyError[K]{Key: k, Err: err}
+				}
+				r[k] = v
+			} else {
+				r[k] = y
+			}
+		}
+	}
+	return r, nil
+}
+
+// Merge nested maps into a new map; values of type map[string]any present in multiple maps are merged recursively,
+// other values present in multiple maps are resolved by the given strategy (such as LastWins, FirstWins,
+// ErrorOnConflict, AppendSlices(), or a custom function).
+// The input maps remain unchanged, and nested values of type map[string]any or []any are copied (so the result
+// can be modified without affecting the inputs).
+// Returns an error (wrapped into a *PathError) if the strategy fails; in that case, the returned map is nil.
+// If all inputs are nil, it will return nil; otherwise, if the result is empty, it will return an empty map.
+func DeepMerge(strategy MergeStrategy[string, any], m ...map[string]any) (map[string]any, error) {
+	if slices.All(m, func(m map[string]any) bool { return m == nil }) {
+		return nil, nil
+	}
+	r := make(map[string]any)
+	for _, m := range m {
+		if err := deepMergeInto(r, m, strategy, nil); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Merge nested maps base, ours and theirs, where ours and theirs are assumed to be derived from base.
+// For each key (recursively for nested values of type map[string]any), changes made in only one of ours and theirs
+// (including additions and deletions) are applied; if both made the same change, it is applied once.
+// If both changed a key differently, a conflict is reported (ordered by path), and the value of ours is kept.
+// Values are compared as for reflect.DeepEqual(). The input maps remain unchanged, and nested values of type
+// map[string]any or []any are copied.
+func ThreeWayMerge(base map[string]any, ours map[string]any, theirs map[string]any) (map[string]any, []Conflict) {
+	r := make(map[string]any)
+	var conflicts []Conflict
+	threeWayMergeInto(r, base, ours, theirs, nil, &conflicts)
+	slices.SortStableFunc(conflicts, func(x, y Conflict) int {
+		return comparePaths(x.Path, y.Path)
+	})
+	return r, conflicts
+}
+
+func deepMergeInto(r map[string]any, m map[string]any, strategy MergeStrategy[string, any], path []string) error {
+	for k, y := range m {
+		x, ok := r[k]
+		if !ok {
+			r[k] = deepCopy(y)
+			continue
+		}
+		p := append(path[:len(path):len(path)], k)
+		if xm, ok := x.(map[string]any); ok {
+			if ym, ok := y.(map[string]any); ok {
+				if err := deepMergeInto(xm, ym, strategy, p); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		v, err := strategy(k, x, deepCopy(y))
+		if err != nil {
+			return &PathError{Path: p, Err: err}
+		}
+		r[k] = v
+	}
+	return nil
+}
+
+func threeWayMergeInto(r map[string]any, base map[string]any, ours map[string]any, theirs map[string]any, path []string, conflicts *[]Conflict) {
+	keys := make(map[string]struct{})
+	for _, m := range []map[string]any{base, ours, theirs} {
+		for k := range m {
+			keys[k] = struct{}{}
+		}
+	}
+	for k := range keys {
+		b, bok := base[k]
+		o, ook := ours[k]
+		t, tok := theirs[k]
+		switch {
+		case ook == tok && reflect.DeepEqual(o, t), ook == bok && reflect.DeepEqual(o, b):
+			if tok {
+				r[k] = deepCopy(t)
+			}
+		case tok == bok && reflect.DeepEqual(t, b):
+			if ook {
+				r[k] = deepCopy(o)
+			}
+		default:
+			p := append(path[:len(path):len(path)], k)
+			om, ok := o.(map[string]any)
+			tm, ok2 := t.(map[string]any)
+			if ok && ok2 {
+				bm, _ := b.(map[string]any)
+				rm := make(map[string]any)
+				threeWayMergeInto(rm, bm, om, tm, p, conflicts)
+				r[k] = rm
+				continue
+			}
+			*conflicts = append(*conflicts, Conflict{Path: p, Base: b, Ours: o, Theirs: t})
+			if ook {
+				r[k] = deepCopy(o)
+			}
+		}
+	}
+}
+
+// Compare paths lexicographically.
+func comparePaths(p []string, q []string) int {
+	for i := 0; i < len(p) && i < len(q); i++ {
+		if r := strings.Compare(p[i], q[i]); r != 0 {
+			return r
+		}
+	}
+	return len(p) - len(q)
+}
+
+// Copy values of type map[string]any or []any recursively; return other values unchanged.
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if v == nil {
+			return v
+		}
+		r := make(map[string]any, len(v))
+		for k, w := range v {
+			r[k] = deepCopy(w)
+		}
+		return r
+	case []any:
+		if v == nil {
+			return v
+		}
+		r := make([]any, len(v))
+		for i, w := range v {
+			r[i] = deepCopy(w)
+		}
+		return r
+	default:
+		return v
+	}
+}
@@ -0,0 +1,204 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+)
+
+var _ = Describe("maps (merge)", func() {
+	Describe("tests for Merge()", func() {
+		var mapA map[string]int
+		var mapB map[string]int
+		var mapC map[string]int
+
+		BeforeEach(func() {
+			mapA = map[string]int{"a": 1, "b": 2}
+			mapB = map[string]int{"b": 3, "c": 4}
+			mapC = map[string]int{"c": 4, "d": 5}
+		})
+
+		Context("with nil maps", func() {
+			It("should return nil", func() {
+				r, err := maps.Merge[string, int](maps.LastWins, nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(BeNil())
+			})
+		})
+		Context("with strategy LastWins", func() {
+			It("should let latter values win", func() {
+				r, err := maps.Merge(maps.LastWins, mapA, nil, mapB, mapC)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]int{"a": 1, "b": 3, "c": 4, "d": 5}))
+				Expect(mapA).To(Equal(map[string]int{"a": 1, "b": 2}))
+			})
+		})
+		Context("with strategy FirstWins", func() {
+			It("should let former values win", func() {
+				r, err := maps.Merge(maps.FirstWins, mapA, mapB, mapC)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]int{"a": 1, "b": 2, "c": 4, "d": 5}))
+			})
+		})
+		Context("with strategy ErrorOnConflict", func() {
+			It("should accept equal values", func() {
+				r, err := maps.Merge(maps.ErrorOnConflict, mapB, mapC)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]int{"b": 3, "c": 4, "d": 5}))
+			})
+			It("should fail on different values", func() {
+				r, err := maps.Merge(maps.ErrorOnConflict, mapA, mapB)
+				Expect(err).To(MatchError(maps.ErrConflict))
+				Expect(err).To(MatchError("key b: conflicting values"))
+				Expect(r).To(BeNil())
+			})
+		})
+		Context("with a custom strategy", func() {
+			It("should resolve conflicts by the given function", func() {
+				r, err := maps.Merge(func(k string, x int, y int) (int, error) { return x + y, nil }, mapA, mapB, mapC)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]int{"a": 1, "b": 5, "c": 8, "d": 5}))
+			})
+		})
+	})
+
+	Describe("tests for DeepMerge()", func() {
+		var defaults map[string]any
+		var overrides map[string]any
+
+		BeforeEach(func() {
+			defaults = map[string]any{
+				"name": "app",
+				"server": map[string]any{
+					"port": 80,
+					"tls":  map[string]any{"enabled": false},
+				},
+				"tags": []any{"a"},
+			}
+			overrides = map[string]any{
+				"server": map[string]any{
+					"tls":  map[string]any{"enabled": true, "cert": "x"},
+					"host": "h",
+				},
+				"tags": []any{"b"},
+			}
+		})
+
+		Context("with nil maps", func() {
+			It("should return nil", func() {
+				r, err := maps.DeepMerge(maps.LastWins, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(BeNil())
+			})
+		})
+		Context("with strategy LastWins", func() {
+			It("should merge nested maps recursively, and replace other values", func() {
+				r, err := maps.DeepMerge(maps.LastWins, defaults, overrides)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(map[string]any{
+					"name": "app",
+					"server": map[string]any{
+						"port": 80,
+						"tls":  map[string]any{"enabled": true, "cert": "x"},
+						"host": "h",
+					},
+					"tags": []any{"b"},
+				}))
+			})
+			It("should leave the inputs unchanged, and not share nested maps or slices with them", func() {
+				r, err := maps.DeepMerge(maps.LastWins, defaults, overrides)
+				Expect(err).NotTo(HaveOccurred())
+				r["server"].(map[string]any)["tls"].(map[string]any)["enabled"] = "changed"
+				r["tags"].([]any)[0] = "changed"
+				Expect(defaults["server"].(map[string]any)["tls"]).To(Equal(map[string]any{"enabled": false}))
+				Expect(overrides["server"].(map[string]any)["tls"]).To(Equal(map[string]any{"enabled": true, "cert": "x"}))
+				Expect(overrides["tags"]).To(Equal([]any{"b"}))
+			})
+		})
+		Context("with strategy AppendSlices()", func() {
+			It("should concatenate slices", func() {
+				r, err := maps.DeepMerge(maps.AppendSlices(maps.LastWins), defaults, overrides, map[string]any{"tags": []any{"c"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r["tags"]).To(Equal([]any{"a", "b", "c"}))
+				Expect(r["server"].(map[string]any)["tls"]).To(Equal(map[string]any{"enabled": true, "cert": "x"}))
+			})
+		})
+		Context("with strategy ErrorOnConflict", func() {
+			It("should report the path of the conflict", func() {
+				_, err := maps.DeepMerge(maps.ErrorOnConflict, defaults, map[string]any{"server": map[string]any{"tls": map[string]any{"enabled": true}}})
+				Expect(err).To(MatchError(maps.ErrConflict))
+				var pathError *maps.PathError
+				Expect(errors.As(err, &pathError)).To(BeTrue())
+				Expect(pathError.Path).To(Equal([]string{"server", "tls", "enabled"}))
+				Expect(err).To(MatchError("path server.tls.enabled: conflicting values"))
+			})
+			It("should fail if a map conflicts with another value", func() {
+				_, err := maps.DeepMerge(maps.ErrorOnConflict, defaults, map[string]any{"server": "s"})
+				Expect(err).To(MatchError("path server: conflicting values"))
+			})
+		})
+	})
+
+	Describe("tests for ThreeWayMerge()", func() {
+		var base map[string]any
+
+		BeforeEach(func() {
+			base = map[string]any{
+				"a": 1,
+				"b": 2,
+				"c": 3,
+				"nested": map[string]any{
+					"x": 1,
+					"y": 2,
+				},
+			}
+		})
+
+		Context("with non-conflicting changes", func() {
+			It("should apply the changes of both sides", func() {
+				ours := map[string]any{"a": 10, "b": 2, "c": 3, "nested": map[string]any{"x": 1, "y": 2, "z": 3}, "new": true}
+				theirs := map[string]any{"a": 1, "b": 20, "nested": map[string]any{"x": 1}, "new": true}
+				r, conflicts := maps.ThreeWayMerge(base, ours, theirs)
+				Expect(conflicts).To(BeEmpty())
+				Expect(r).To(Equal(map[string]any{"a": 10, "b": 20, "nested": map[string]any{"x": 1, "z": 3}, "new": true}))
+			})
+		})
+		Context("with conflicting changes", func() {
+			It("should report the conflicts ordered by path, and keep our values", func() {
+				ours := map[string]any{"a": 10, "b": 2, "nested": map[string]any{"x": 5, "y": 2}, "new": 1}
+				theirs := map[string]any{"a": 11, "b": 2, "c": 30, "nested": map[string]any{"x": 6}, "new": 2}
+				r, conflicts := maps.ThreeWayMerge(base, ours, theirs)
+				Expect(r).To(Equal(map[string]any{"a": 10, "b": 2, "nested": map[string]any{"x": 5}, "new": 1}))
+				Expect(conflicts).To(Equal([]maps.Conflict{
+					{Path: []string{"a"}, Base: 1, Ours: 10, Theirs: 11},
+					{Path: []string{"c"}, Base: 3, Ours: nil, Theirs: 30},
+					{Path: []string{"nested", "x"}, Base: 1, Ours: 5, Theirs: 6},
+					{Path: []string{"new"}, Base: nil, Ours: 1, Theirs: 2},
+				}))
+			})
+		})
+		Context("with nil maps", func() {
+			It("should return an empty map", func() {
+				r, conflicts := maps.ThreeWayMerge(nil, nil, nil)
+				Expect(r).To(Equal(map[string]any{}))
+				Expect(conflicts).To(BeEmpty())
+			})
+		})
+		Context("with a nested map added on both sides", func() {
+			It("should merge the nested maps", func() {
+				r, conflicts := maps.ThreeWayMerge(nil, map[string]any{"m": map[string]any{"a": 1}}, map[string]any{"m": map[string]any{"b": 2}})
+				Expect(conflicts).To(BeEmpty())
+				Expect(r).To(Equal(map[string]any{"m": map[string]any{"a": 1, "b": 2}}))
+				Expect(fmt.Sprint(r)).To(Equal("map[m:map[a:1 b:2]]"))
+			})
+		})
+	})
+})
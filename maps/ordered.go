@@ -0,0 +1,358 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+
+	"github.com/sap/go-generics/internal/reflection"
+	"github.com/sap/go-generics/pairs"
+)
+
+// Map preserving insertion order.
+// The zero value is an empty map, ready to use. Ordered maps must not be copied after first use.
+type OrderedMap[K comparable, V any] struct {
+	m    map[K]*orderedEntry[K, V]
+	head *orderedEntry[K, V]
+	tail *orderedEntry[K, V]
+	// number of moves so far; allows iterators to recognize entries moved after they started
+	moves uint64
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedEntry[K, V]
+	next  *orderedEntry[K, V]
+	// set when the entry is deleted; the links of deleted entries are kept, so that iterators can continue from them
+	removed bool
+	// value of the map's move counter when the entry was last moved
+	moved uint64
+}
+
+// Create new ordered map, containing the given entries (in the given order).
+// If the entries contain duplicate keys, the according latter values win.
+func NewOrderedMap[K comparable, V any](entries ...pairs.Pair[K, V]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	for _, e := range entries {
+		m.Set(e.X, e.Y)
+	}
+	return m
+}
+
+// Get number of entries.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.m)
+}
+
+// Check if map contains given key.
+func (m *OrderedMap[K, V]) Has(k K) bool {
+	_, ok := m.m[k]
+	return ok
+}
+
+// Get value for given key; the second return value reports whether the key exists.
+func (m *OrderedMap[K, V]) Get(k K) (V, bool) {
+	if e, ok := m.m[k]; ok {
+		return e.value, true
+	}
+	var v V
+	return v, false
+}
+
+// Set value for given key.
+// If the key already exists, its value will be updated, and its position remains unchanged;
+// otherwise the entry will be appended at the end.
+func (m *OrderedMap[K, V]) Set(k K, v V) {
+	if e, ok := m.m[k]; ok {
+		e.value = v
+		return
+	}
+	if m.m == nil {
+		m.m = make(map[K]*orderedEntry[K, V])
+	}
+	e := &orderedEntry[K, V]{key: k, value: v}
+	m.m[k] = e
+	m.pushBack(e)
+}
+
+// Delete entry for given key; reports whether the key existed.
+func (m *OrderedMap[K, V]) Delete(k K) bool {
+	e, ok := m.m[k]
+	if !ok {
+		return false
+	}
+	delete(m.m, k)
+	m.unlink(e)
+	e.removed = true
+	return true
+}
+
+// Move entry for given key to the front; reports whether the key exists.
+func (m *OrderedMap[K, V]) MoveToFront(k K) bool {
+	e, ok := m.m[k]
+	if !ok {
+		return false
+	}
+	if e != m.head {
+		m.unlink(e)
+		m.pushFront(e)
+		m.moved(e)
+	}
+	return true
+}
+
+// Move entry for given key to the back; reports whether the key exists.
+func (m *OrderedMap[K, V]) MoveToBack(k K) bool {
+	e, ok := m.m[k]
+	if !ok {
+		return false
+	}
+	if e != m.tail {
+		m.unlink(e)
+		m.pushBack(e)
+		m.moved(e)
+	}
+	return true
+}
+
+// Get keys in order.
+// Will return an empty non-nil slice in case the map is empty.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.m))
+	for e := m.head; e != nil; e = e.next {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// Get values in order (of the according keys).
+// Will return an empty non-nil slice in case the map is empty.
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.m))
+	for e := m.head; e != nil; e = e.next {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+// Get all entries as sequence, in order.
+// Any entries may be deleted while iterating (deleted entries which were not yet reached are not yielded);
+// entries added while iterating may or may not be yielded. Only the current entry may be moved while iterating
+// (in which case it is not yielded again).
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		moves := m.moves
+		for e := m.head; e != nil; {
+			next := e.next
+			if !yield(e.key, e.value) {
+				return
+			}
+			for e = next; e != nil && e.skip(moves); e = e.next {
+			}
+		}
+	}
+}
+
+// Get all entries as sequence, in reverse order.
+// Modifications while iterating are handled as for All().
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		moves := m.moves
+		for e := m.tail; e != nil; {
+			prev := e.prev
+			if !yield(e.key, e.value) {
+				return
+			}
+			for e = prev; e != nil && e.skip(moves); e = e.prev {
+			}
+		}
+	}
+}
+
+// Get (unordered) map containing the entries of the ordered map.
+// The returned map can be compared by Equal() or EqualBy().
+// If the ordered map is empty, an empty (non-nil) map will be returned.
+func (m *OrderedMap[K, V]) Map() map[K]V {
+	n := make(map[K]V, len(m.m))
+	for k, e := range m.m {
+		n[k] = e.value
+	}
+	return n
+}
+
+// Clone ordered map.
+func (m *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	n := &OrderedMap[K, V]{}
+	for e := m.head; e != nil; e = e.next {
+		n.Set(e.key, e.value)
+	}
+	return n
+}
+
+// Marshal ordered map to JSON; implements json.Marshaler.
+// The map is encoded as object, with the keys in order. Keys must be strings, integers,
+// or implement encoding.TextMarshaler (as for ordinary maps).
+// Uses a value receiver, so that ordered maps held by value (e.g. as struct fields) are encoded correctly.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for e := m.head; e != nil; e = e.next {
+		if e != m.head {
+			buf.WriteByte(',')
+		}
+		key, err := marshalKey(e.key)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+		buf.WriteByte(':')
+		data, err = json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Unmarshal ordered map from JSON; implements json.Unmarshaler.
+// Accepts an object or null; the order of the keys is preserved; existing entries are discarded.
+// If the object contains duplicate keys, the latter values win (keeping the position of the first occurrence).
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	n := &OrderedMap[K, V]{}
+	if t == nil {
+		*m = *n
+		return nil
+	}
+	if d, ok := t.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cannot unmarshal %v into ordered map", t)
+	}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		k, err := unmarshalKey[K](t.(string))
+		if err != nil {
+			return err
+		}
+		var v V
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		n.Set(k, v)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	*m = *n
+	return nil
+}
+
+func (m *OrderedMap[K, V]) pushFront(e *orderedEntry[K, V]) {
+	e.prev = nil
+	e.next = m.head
+	if m.head != nil {
+		m.head.prev = e
+	} else {
+		m.tail = e
+	}
+	m.head = e
+}
+
+func (m *OrderedMap[K, V]) pushBack(e *orderedEntry[K, V]) {
+	e.next = nil
+	e.prev = m.tail
+	if m.tail != nil {
+		m.tail.next = e
+	} else {
+		m.head = e
+	}
+	m.tail = e
+}
+
+// Record that e was moved.
+func (m *OrderedMap[K, V]) moved(e *orderedEntry[K, V]) {
+	m.moves++
+	e.moved = m.moves
+}
+
+// Check if an iterator which started when the map's move counter had the given value must skip the entry,
+// because it was deleted, or moved (and therefore already yielded) since then.
+func (e *orderedEntry[K, V]) skip(moves uint64) bool {
+	return e.removed || e.moved > moves
+}
+
+func (m *OrderedMap[K, V]) unlink(e *orderedEntry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		m.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.tail = e.prev
+	}
+}
+
+// Compare two ordered maps by a given equality function (for values, keys are still compared by ==).
+// Other than EqualBy(), this also requires the keys to be in the same order.
+func OrderedEqualBy[K comparable, V any, W any](m *OrderedMap[K, V], n *OrderedMap[K, W], f func(V, W) bool) bool {
+	if m.Len() != n.Len() {
+		return false
+	}
+	e, g := m.head, n.head
+	for e != nil && g != nil {
+		if e.key != g.key || !f(e.value, g.value) {
+			return false
+		}
+		e, g = e.next, g.next
+	}
+	return true
+}
+
+// Compare two ordered maps of comparable values.
+// Other than Equal(), this also requires the keys to be in the same order.
+func OrderedEqual[K comparable, V comparable](m *OrderedMap[K, V], n *OrderedMap[K, V]) bool {
+	f := func(x V, y V) bool {
+		return x == y
+	}
+	return OrderedEqualBy(m, n, f)
+}
+
+// Convert map key into JSON object key (following the rules of encoding/json).
+func marshalKey[K comparable](k K) (string, error) {
+	key, ok, err := reflection.MarshalText(k, reflection.JSONKeyRules)
+	if !ok {
+		return "", fmt.Errorf("unsupported map key type %s", reflect.TypeFor[K]())
+	}
+	return key, err
+}
+
+// Convert JSON object key into map key (following the rules of encoding/json).
+func unmarshalKey[K comparable](s string) (K, error) {
+	k, ok, err := reflection.UnmarshalText[K](s, reflection.JSONKeyRules)
+	if !ok {
+		return k, fmt.Errorf("unsupported map key type %s", reflect.TypeFor[K]())
+	}
+	return k, err
+}
@@ -0,0 +1,225 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+	"github.com/sap/go-generics/pairs"
+)
+
+var _ = Describe("maps (ordered map)", func() {
+	var emptyMap *maps.OrderedMap[string, int]
+	var mapA *maps.OrderedMap[string, int]
+
+	BeforeEach(func() {
+		emptyMap = &maps.OrderedMap[string, int]{}
+		mapA = maps.NewOrderedMap(pairs.Pair[string, int]{X: "c", Y: 3}, pairs.Pair[string, int]{X: "a", Y: 1}, pairs.Pair[string, int]{X: "b", Y: 2})
+	})
+
+	Describe("tests for an empty map", func() {
+		It("should behave like an empty map", func() {
+			Expect(emptyMap.Len()).To(Equal(0))
+			Expect(emptyMap.Has("a")).To(BeFalse())
+			_, ok := emptyMap.Get("a")
+			Expect(ok).To(BeFalse())
+			Expect(emptyMap.Delete("a")).To(BeFalse())
+			Expect(emptyMap.MoveToFront("a")).To(BeFalse())
+			Expect(emptyMap.Keys()).To(Equal([]string{}))
+			Expect(emptyMap.Values()).To(Equal([]int{}))
+			Expect(emptyMap.Map()).To(Equal(map[string]int{}))
+		})
+	})
+
+	Describe("tests for Get() and Set()", func() {
+		Context("with a new key", func() {
+			It("should append the entry", func() {
+				mapA.Set("d", 4)
+				v, ok := mapA.Get("d")
+				Expect(ok).To(BeTrue())
+				Expect(v).To(Equal(4))
+				Expect(mapA.Keys()).To(Equal([]string{"c", "a", "b", "d"}))
+			})
+		})
+		Context("with an existing key", func() {
+			It("should update the value and keep the position", func() {
+				mapA.Set("c", 5)
+				v, ok := mapA.Get("c")
+				Expect(ok).To(BeTrue())
+				Expect(v).To(Equal(5))
+				Expect(mapA.Keys()).To(Equal([]string{"c", "a", "b"}))
+				Expect(mapA.Values()).To(Equal([]int{5, 1, 2}))
+			})
+		})
+		Context("with a zero value map", func() {
+			It("should initialize the map", func() {
+				emptyMap.Set("x", 1)
+				Expect(emptyMap.Len()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("tests for Delete()", func() {
+		It("should remove the entry", func() {
+			Expect(mapA.Delete("a")).To(BeTrue())
+			Expect(mapA.Keys()).To(Equal([]string{"c", "b"}))
+			Expect(mapA.Delete("c")).To(BeTrue())
+			Expect(mapA.Delete("b")).To(BeTrue())
+			Expect(mapA.Keys()).To(BeEmpty())
+			mapA.Set("d", 4)
+			Expect(mapA.Keys()).To(Equal([]string{"d"}))
+		})
+	})
+
+	Describe("tests for MoveToFront() and MoveToBack()", func() {
+		It("should reorder the entries", func() {
+			Expect(mapA.MoveToFront("b")).To(BeTrue())
+			Expect(mapA.Keys()).To(Equal([]string{"b", "c", "a"}))
+			Expect(mapA.MoveToBack("b")).To(BeTrue())
+			Expect(mapA.Keys()).To(Equal([]string{"c", "a", "b"}))
+			Expect(mapA.MoveToBack("b")).To(BeTrue())
+			Expect(mapA.Keys()).To(Equal([]string{"c", "a", "b"}))
+			Expect(mapA.MoveToBack("x")).To(BeFalse())
+		})
+	})
+
+	Describe("tests for All() and Backward()", func() {
+		It("should yield the entries in order", func() {
+			var keys []string
+			for k := range mapA.All() {
+				keys = append(keys, k)
+			}
+			Expect(keys).To(Equal([]string{"c", "a", "b"}))
+			keys = nil
+			for k := range mapA.Backward() {
+				keys = append(keys, k)
+			}
+			Expect(keys).To(Equal([]string{"b", "a", "c"}))
+		})
+		It("should allow deleting while iterating", func() {
+			for k := range mapA.All() {
+				mapA.Delete(k)
+			}
+			Expect(mapA.Len()).To(Equal(0))
+		})
+		It("should not yield entries deleted while iterating, and continue with the remaining ones", func() {
+			m := maps.NewOrderedMap(pairs.Make("a", 1), pairs.Make("b", 2), pairs.Make("c", 3), pairs.Make("d", 4))
+			var keys []string
+			for k := range m.All() {
+				keys = append(keys, k)
+				if k == "a" {
+					m.Delete("b")
+					m.Delete("c")
+				}
+			}
+			Expect(keys).To(Equal([]string{"a", "d"}))
+			m = maps.NewOrderedMap(pairs.Make("a", 1), pairs.Make("b", 2), pairs.Make("c", 3), pairs.Make("d", 4))
+			keys = nil
+			for k := range m.Backward() {
+				keys = append(keys, k)
+				if k == "d" {
+					m.Delete("c")
+				}
+			}
+			Expect(keys).To(Equal([]string{"d", "b", "a"}))
+		})
+		It("should allow moving the current entry while iterating, without yielding it again", func() {
+			var keys []string
+			for k := range mapA.All() {
+				keys = append(keys, k)
+				if k == "c" {
+					mapA.MoveToBack(k)
+				}
+			}
+			Expect(keys).To(Equal([]string{"c", "a", "b"}))
+			Expect(mapA.Keys()).To(Equal([]string{"a", "b", "c"}))
+			keys = nil
+			for k := range mapA.All() {
+				keys = append(keys, k)
+				mapA.MoveToBack(k)
+			}
+			Expect(keys).To(Equal([]string{"a", "b", "c"}))
+			Expect(mapA.Keys()).To(Equal([]string{"a", "b", "c"}))
+			keys = nil
+			for k := range mapA.Backward() {
+				keys = append(keys, k)
+				if k == "c" {
+					mapA.MoveToFront(k)
+				}
+			}
+			Expect(keys).To(Equal([]string{"c", "b", "a"}))
+			Expect(mapA.Keys()).To(Equal([]string{"c", "a", "b"}))
+			keys = nil
+			for k := range mapA.Backward() {
+				keys = append(keys, k)
+				mapA.MoveToFront(k)
+			}
+			Expect(keys).To(Equal([]string{"b", "a", "c"}))
+			Expect(mapA.Keys()).To(Equal([]string{"c", "a", "b"}))
+		})
+	})
+
+	Describe("tests for Map(), OrderedEqual() and Clone()", func() {
+		It("should compare the entries", func() {
+			other := maps.NewOrderedMap(pairs.Pair[string, int]{X: "a", Y: 1}, pairs.Pair[string, int]{X: "b", Y: 2}, pairs.Pair[string, int]{X: "c", Y: 3})
+			Expect(maps.Equal(mapA.Map(), other.Map())).To(BeTrue())
+			Expect(maps.OrderedEqual(mapA, other)).To(BeFalse())
+			other.MoveToFront("c")
+			Expect(maps.OrderedEqual(mapA, other)).To(BeTrue())
+			clone := mapA.Clone()
+			Expect(maps.OrderedEqual(mapA, clone)).To(BeTrue())
+			clone.Set("c", 0)
+			Expect(maps.OrderedEqual(mapA, clone)).To(BeFalse())
+		})
+	})
+
+	Describe("tests for JSON marshalling", func() {
+		It("should preserve the key order", func() {
+			Expect(json.Marshal(mapA)).To(Equal([]byte(`{"c":3,"a":1,"b":2}`)))
+			Expect(json.Marshal(emptyMap)).To(Equal([]byte(`{}`)))
+			Expect(json.Marshal(maps.NewOrderedMap(pairs.Pair[int, string]{X: 2, Y: "x"}, pairs.Pair[int, string]{X: 1, Y: "y"}))).To(Equal([]byte(`{"2":"x","1":"y"}`)))
+		})
+		It("should roundtrip ordered maps held by value in a struct", func() {
+			type object struct {
+				Labels maps.OrderedMap[string, int] `json:"labels"`
+			}
+			var o object
+			o.Labels.Set("z", 1)
+			o.Labels.Set("a", 2)
+			data, err := json.Marshal(o)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal([]byte(`{"labels":{"z":1,"a":2}}`)))
+			var p object
+			Expect(json.Unmarshal(data, &p)).To(Succeed())
+			Expect(p.Labels.Keys()).To(Equal([]string{"z", "a"}))
+		})
+	})
+
+	Describe("tests for JSON unmarshalling", func() {
+		It("should preserve the key order", func() {
+			m := &maps.OrderedMap[string, []int]{}
+			Expect(json.Unmarshal([]byte(`{"z":[1],"y":null,"x":[2,3]}`), m)).To(Succeed())
+			Expect(m.Keys()).To(Equal([]string{"z", "y", "x"}))
+			Expect(m.Values()).To(Equal([][]int{{1}, nil, {2, 3}}))
+		})
+		It("should accept null and integer keys", func() {
+			m := maps.NewOrderedMap(pairs.Pair[int, string]{X: 1, Y: "a"})
+			Expect(json.Unmarshal([]byte(`null`), m)).To(Succeed())
+			Expect(m.Len()).To(Equal(0))
+			Expect(json.Unmarshal([]byte(`{"3":"a","1":"b"}`), m)).To(Succeed())
+			Expect(m.Keys()).To(Equal([]int{3, 1}))
+		})
+		It("should reject invalid input", func() {
+			m := &maps.OrderedMap[int, string]{}
+			Expect(json.Unmarshal([]byte(`[]`), m)).NotTo(Succeed())
+			Expect(json.Unmarshal([]byte(`{"x":"a"}`), m)).NotTo(Succeed())
+			Expect(json.Unmarshal([]byte(`{"1":1}`), m)).NotTo(Succeed())
+		})
+	})
+})
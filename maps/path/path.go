@@ -0,0 +1,313 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package path provides access to nested documents consisting of map[string]any and []any values
+// (such as the result of unmarshalling JSON into an any value), addressed by paths.
+package path
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sap/go-generics/maps"
+	"github.com/sap/go-generics/slices"
+)
+
+// Path, as a sequence of tokens; each token is a map key, or an index into a slice.
+// The empty path refers to the document itself.
+type Path []string
+
+// Error returned by Walk() callbacks to skip the children of the current value.
+var SkipChildren = errors.New("skip children")
+
+// Parse path, either as JSON Pointer (RFC 6901; if the string starts with a slash),
+// or as dotted path otherwise (see ParseDotted()). The empty string is parsed as the empty path.
+func Parse(s string) (Path, error) {
+	if strings.HasPrefix(s, "/") {
+		return ParsePointer(s)
+	}
+	return ParseDotted(s), nil
+}
+
+// Parse path, panicking if the string is not a valid path.
+func MustParse(s string) Path {
+	p, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Parse dotted path, such as a.b.0.c; dots and backslashes within tokens can be escaped by a backslash.
+// The empty string is parsed as the empty path.
+func ParseDotted(s string) Path {
+	if s == "" {
+		return Path{}
+	}
+	p := Path{}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case s[i] == '.':
+			p = append(p, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return append(p, b.String())
+}
+
+// Parse JSON Pointer (RFC 6901), such as /a/b/0/c; within tokens, ~0 denotes a tilde, and ~1 a slash.
+// The empty string is parsed as the empty path.
+func ParsePointer(s string) (Path, error) {
+	if s == "" {
+		return Path{}, nil
+	}
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must be empty or start with a slash", s)
+	}
+	p := Path(strings.Split(s[1:], "/"))
+	for i, t := range p {
+		for j := 0; j < len(t); j++ {
+			if t[j] == '~' && (j+1 >= len(t) || (t[j+1] != '0' && t[j+1] != '1')) {
+				return nil, fmt.Errorf("invalid JSON pointer %q: invalid escape sequence", s)
+			}
+		}
+		p[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return p, nil
+}
+
+// Format path as dotted path (escaping dots and backslashes within tokens).
+func (p Path) String() string {
+	r := strings.NewReplacer(`\`, `\\`, `.`, `\.`)
+	s := make([]string, len(p))
+	for i, t := range p {
+		s[i] = r.Replace(t)
+	}
+	return strings.Join(s, ".")
+}
+
+// Format path as JSON Pointer (RFC 6901).
+func (p Path) Pointer() string {
+	r := strings.NewReplacer(`~`, `~0`, `/`, `~1`)
+	var b strings.Builder
+	for _, t := range p {
+		b.WriteByte('/')
+		b.WriteString(r.Replace(t))
+	}
+	return b.String()
+}
+
+// Get value at given path in document.
+// Slices are indexed by decimal tokens (without leading zeros).
+// Returns false if the path does not exist.
+func Get(doc any, p Path) (any, bool) {
+	x := doc
+	for _, t := range p {
+		switch y := x.(type) {
+		case map[string]any:
+			v, ok := y[t]
+			if !ok {
+				return nil, false
+			}
+			x = v
+		case []any:
+			i, ok := parseIndex(t, len(y))
+			if !ok || i == len(y) {
+				return nil, false
+			}
+			x = y[i]
+		default:
+			return nil, false
+		}
+	}
+	return x, true
+}
+
+// Get value of given type at given path in document.
+// Returns false if the path does not exist, or the value is not of the given type.
+func GetAs[T any](doc any, p Path) (T, bool) {
+	v, ok := Get(doc, p)
+	r, ok2 := v.(T)
+	return r, ok && ok2
+}
+
+// Set value at given path in document (which must not be nil).
+// Missing (or nil) intermediate values are created as map[string]any. Slice elements can be replaced by specifying
+// their index; the token - (or the length of the slice) appends to the slice.
+// Returns an error if the path is empty, or if it traverses a value which is neither a map[string]any
+// nor a []any, or an invalid slice index; in that case, the document remains unchanged.
+func Set(doc map[string]any, p Path, v any) error {
+	if doc == nil {
+		return errors.New("cannot set value in nil document")
+	}
+	if len(p) == 0 {
+		return errors.New("cannot set value at empty path")
+	}
+	if err := check(doc, p); err != nil {
+		return err
+	}
+	set(doc, p, v)
+	return nil
+}
+
+// Delete value at given path from document; slice elements are removed (shifting subsequent elements).
+// Returns false if the path does not exist (or is empty).
+func Delete(doc map[string]any, p Path) bool {
+	if len(p) == 0 {
+		return false
+	}
+	_, ok := del(doc, p)
+	return ok
+}
+
+// Walk document depth-first (pre-order), calling given function for each value (including the document itself,
+// with the empty path); map entries are visited in key order, slice elements in index order.
+// If the function returns SkipChildren, the children of the current value are skipped; if it returns another error,
+// the walk stops, and the error is returned. The path passed to the function may be retained by the caller.
+func Walk(doc any, f func(p Path, v any) error) error {
+	err := walk(Path{}, doc, f)
+	if err == SkipChildren {
+		return nil
+	}
+	return err
+}
+
+func walk(p Path, x any, f func(p Path, v any) error) error {
+	if err := f(p, x); err != nil {
+		return err
+	}
+	visit := func(t string, v any) error {
+		err := walk(append(p[:len(p):len(p)], t), v, f)
+		if err == SkipChildren {
+			return nil
+		}
+		return err
+	}
+	switch x := x.(type) {
+	case map[string]any:
+		for _, k := range slices.Sort(maps.Keys(x)) {
+			if err := visit(k, x[k]); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, v := range x {
+			if err := visit(strconv.Itoa(i), v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Check that value can be set at given path in x.
+func check(x any, p Path) error {
+	for i, t := range p {
+		switch y := x.(type) {
+		case nil:
+			return nil
+		case map[string]any:
+			x = y[t]
+		case []any:
+			j, ok := parseIndex(t, len(y))
+			if !ok {
+				return fmt.Errorf("invalid index %q at path %s", t, p[:i+1])
+			}
+			if j == len(y) {
+				return nil
+			}
+			x = y[j]
+		default:
+			return fmt.Errorf("cannot traverse value of type %T at path %s", x, p[:i])
+		}
+	}
+	return nil
+}
+
+// Set value at given (checked) path in x, returning the updated x (which differs from x if x is a slice being appended to,
+// or nil).
+func set(x any, p Path, v any) any {
+	if len(p) == 0 {
+		return v
+	}
+	t := p[0]
+	switch y := x.(type) {
+	case map[string]any:
+		if y == nil {
+			y = make(map[string]any)
+		}
+		y[t] = set(y[t], p[1:], v)
+		return y
+	case []any:
+		i, _ := parseIndex(t, len(y))
+		if i == len(y) {
+			return append(y, set(nil, p[1:], v))
+		}
+		y[i] = set(y[i], p[1:], v)
+		return y
+	default:
+		return map[string]any{t: set(nil, p[1:], v)}
+	}
+}
+
+// Delete value at given path in x, returning the updated x (which differs from x if x is a slice), and whether
+// the path existed.
+func del(x any, p Path) (any, bool) {
+	t := p[0]
+	switch y := x.(type) {
+	case map[string]any:
+		v, ok := y[t]
+		if !ok {
+			return y, false
+		}
+		if len(p) == 1 {
+			delete(y, t)
+			return y, true
+		}
+		if v, ok = del(v, p[1:]); ok {
+			y[t] = v
+		}
+		return y, ok
+	case []any:
+		i, ok := parseIndex(t, len(y))
+		if !ok || i == len(y) {
+			return y, false
+		}
+		if len(p) == 1 {
+			r := make([]any, 0, len(y)-1)
+			return append(append(r, y[:i]...), y[i+1:]...), true
+		}
+		v, ok := del(y[i], p[1:])
+		if ok {
+			y[i] = v
+		}
+		return y, ok
+	default:
+		return x, false
+	}
+}
+
+// Parse slice index (a decimal number without leading zeros, at most n, or -, meaning n).
+func parseIndex(t string, n int) (int, bool) {
+	if t == "-" {
+		return n, true
+	}
+	if t == "" || (t[0] == '0' && len(t) > 1) || t[0] < '0' || t[0] > '9' {
+		return 0, false
+	}
+	i, err := strconv.Atoi(t)
+	if err != nil || i > n {
+		return 0, false
+	}
+	return i, true
+}
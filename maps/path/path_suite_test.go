@@ -0,0 +1,207 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package path_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps/path"
+)
+
+func TestPath(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Path Suite")
+}
+
+var _ = Describe("path", func() {
+	var doc map[string]any
+
+	// get value at given path, ignoring whether it exists
+	get := func(p string) any {
+		v, _ := path.Get(doc, path.MustParse(p))
+		return v
+	}
+
+	BeforeEach(func() {
+		doc = make(map[string]any)
+		Expect(json.Unmarshal([]byte(`{
+			"metadata": {"name": "x", "labels": {"app.kubernetes.io/name": "web"}},
+			"spec": {"containers": [{"name": "a", "ports": [80, 443]}, {"name": "b"}]},
+			"a~b": 1
+		}`), &doc)).To(Succeed())
+	})
+
+	Describe("tests for parsing and formatting", func() {
+		It("should parse dotted paths", func() {
+			Expect(path.ParseDotted("")).To(Equal(path.Path{}))
+			Expect(path.ParseDotted("a.b.0")).To(Equal(path.Path{"a", "b", "0"}))
+			Expect(path.ParseDotted(`a\.b.c\\`)).To(Equal(path.Path{"a.b", `c\`}))
+			Expect(path.ParseDotted("a..b.")).To(Equal(path.Path{"a", "", "b", ""}))
+		})
+		It("should parse JSON pointers", func() {
+			Expect(path.ParsePointer("")).To(Equal(path.Path{}))
+			Expect(path.ParsePointer("/")).To(Equal(path.Path{""}))
+			Expect(path.ParsePointer("/a/b/0")).To(Equal(path.Path{"a", "b", "0"}))
+			Expect(path.ParsePointer("/a~1b/c~0d/~01")).To(Equal(path.Path{"a/b", "c~d", "~1"}))
+		})
+		It("should reject invalid JSON pointers", func() {
+			_, err := path.ParsePointer("a/b")
+			Expect(err).To(HaveOccurred())
+			_, err = path.ParsePointer("/a~2")
+			Expect(err).To(HaveOccurred())
+			_, err = path.ParsePointer("/a~")
+			Expect(err).To(HaveOccurred())
+		})
+		It("should detect the syntax", func() {
+			Expect(path.Parse("/a.b/c")).To(Equal(path.Path{"a.b", "c"}))
+			Expect(path.Parse("a.b/c")).To(Equal(path.Path{"a", "b/c"}))
+			Expect(func() { path.MustParse("/~") }).To(Panic())
+		})
+		It("should format paths", func() {
+			p := path.Path{"a.b", `c\`, "d/e~"}
+			Expect(p.String()).To(Equal(`a\.b.c\\.d/e~`))
+			Expect(p.Pointer()).To(Equal("/a.b/c\\/d~1e~0"))
+			Expect(path.ParseDotted(p.String())).To(Equal(p))
+			Expect(path.ParsePointer(p.Pointer())).To(Equal(p))
+			Expect(path.Path{}.Pointer()).To(Equal(""))
+		})
+	})
+
+	Describe("tests for Get()", func() {
+		It("should return existing values", func() {
+			Expect(get("")).To(Equal(doc))
+			v, ok := path.Get(doc, path.MustParse("metadata.name"))
+			Expect(v).To(Equal("x"))
+			Expect(ok).To(BeTrue())
+			v, ok = path.Get(doc, path.MustParse("/metadata/labels/app.kubernetes.io~1name"))
+			Expect(v).To(Equal("web"))
+			Expect(ok).To(BeTrue())
+			v, ok = path.Get(doc, path.MustParse("spec.containers.0.ports.1"))
+			Expect(v).To(Equal(443.0))
+			Expect(ok).To(BeTrue())
+			v, ok = path.Get(doc, path.MustParse("/a~0b"))
+			Expect(v).To(Equal(1.0))
+			Expect(ok).To(BeTrue())
+		})
+		It("should return false for non-existing values", func() {
+			for _, p := range []string{"x", "metadata.x", "metadata.name.x", "spec.containers.2", "spec.containers.-", "spec.containers.01", "spec.containers.a"} {
+				v, ok := path.Get(doc, path.MustParse(p))
+				Expect(v).To(BeNil())
+				Expect(ok).To(BeFalse())
+			}
+		})
+		It("should return typed values", func() {
+			s, ok := path.GetAs[string](doc, path.MustParse("spec.containers.1.name"))
+			Expect(s).To(Equal("b"))
+			Expect(ok).To(BeTrue())
+			_, ok = path.GetAs[int](doc, path.MustParse("spec.containers.1.name"))
+			Expect(ok).To(BeFalse())
+			m, ok := path.GetAs[map[string]any](doc, path.MustParse("metadata.labels"))
+			Expect(m).To(HaveKey("app.kubernetes.io/name"))
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Describe("tests for Set()", func() {
+		It("should replace existing values", func() {
+			Expect(path.Set(doc, path.MustParse("metadata.name"), "y")).To(Succeed())
+			Expect(path.Set(doc, path.MustParse("spec.containers.0.ports.0"), 8080)).To(Succeed())
+			Expect(get("metadata.name")).To(Equal("y"))
+			Expect(get("spec.containers.0.ports")).To(Equal([]any{8080, 443.0}))
+		})
+		It("should create missing intermediate maps", func() {
+			Expect(path.Set(doc, path.MustParse("metadata.annotations.a/b"), "c")).To(Succeed())
+			Expect(doc["metadata"]).To(HaveKeyWithValue("annotations", map[string]any{"a/b": "c"}))
+			Expect(path.Set(doc, path.MustParse("x.0.y"), true)).To(Succeed())
+			Expect(doc["x"]).To(Equal(map[string]any{"0": map[string]any{"y": true}}))
+		})
+		It("should replace nil intermediate maps", func() {
+			doc := map[string]any{"a": map[string]any(nil), "b": []any{map[string]any(nil)}}
+			Expect(path.Set(doc, path.MustParse("a.b"), 1)).To(Succeed())
+			Expect(path.Set(doc, path.MustParse("b.0.c"), 2)).To(Succeed())
+			Expect(doc).To(Equal(map[string]any{"a": map[string]any{"b": 1}, "b": []any{map[string]any{"c": 2}}}))
+		})
+		It("should append to slices", func() {
+			Expect(path.Set(doc, path.MustParse("/spec/containers/0/ports/-"), 8443)).To(Succeed())
+			Expect(path.Set(doc, path.MustParse("spec.containers.2.name"), "c")).To(Succeed())
+			Expect(get("spec.containers.0.ports")).To(Equal([]any{80.0, 443.0, 8443}))
+			Expect(get("spec.containers.2")).To(Equal(map[string]any{"name": "c"}))
+		})
+		It("should fail on invalid paths, leaving the document unchanged", func() {
+			Expect(path.Set(doc, path.Path{}, 1)).NotTo(Succeed())
+			Expect(path.Set(nil, path.MustParse("a"), 1)).NotTo(Succeed())
+			Expect(path.Set(doc, path.MustParse("metadata.name.x"), 1)).To(MatchError("cannot traverse value of type string at path metadata.name"))
+			Expect(path.Set(doc, path.MustParse("spec.containers.5.name"), 1)).To(MatchError(`invalid index "5" at path spec.containers.5`))
+			Expect(get("metadata.name")).To(Equal("x"))
+			Expect(get("spec.containers")).To(HaveLen(2))
+		})
+	})
+
+	Describe("tests for Delete()", func() {
+		It("should delete existing values", func() {
+			Expect(path.Delete(doc, path.MustParse("metadata.labels.app\\.kubernetes\\.io/name"))).To(BeTrue())
+			Expect(doc["metadata"]).To(HaveKeyWithValue("labels", map[string]any{}))
+			Expect(path.Delete(doc, path.MustParse("spec.containers.0"))).To(BeTrue())
+			Expect(get("spec.containers")).To(Equal([]any{map[string]any{"name": "b"}}))
+			Expect(path.Delete(doc, path.MustParse("a~b"))).To(BeTrue())
+			Expect(doc).NotTo(HaveKey("a~b"))
+		})
+		It("should return false for non-existing values", func() {
+			for _, p := range []string{"", "x", "metadata.x", "metadata.name.x", "spec.containers.2", "spec.containers.-"} {
+				Expect(path.Delete(doc, path.MustParse(p))).To(BeFalse())
+			}
+			Expect(doc["spec"].(map[string]any)["containers"]).To(HaveLen(2))
+		})
+	})
+
+	Describe("tests for Walk()", func() {
+		It("should visit all values in order", func() {
+			var paths []string
+			Expect(path.Walk(doc["spec"], func(p path.Path, v any) error {
+				paths = append(paths, p.Pointer())
+				return nil
+			})).To(Succeed())
+			Expect(paths).To(Equal([]string{
+				"",
+				"/containers",
+				"/containers/0",
+				"/containers/0/name",
+				"/containers/0/ports",
+				"/containers/0/ports/0",
+				"/containers/0/ports/1",
+				"/containers/1",
+				"/containers/1/name",
+			}))
+		})
+		It("should skip children", func() {
+			var paths []path.Path
+			Expect(path.Walk(doc, func(p path.Path, v any) error {
+				paths = append(paths, p)
+				if len(p) == 1 {
+					return path.SkipChildren
+				}
+				return nil
+			})).To(Succeed())
+			Expect(paths).To(Equal([]path.Path{{}, {"a~b"}, {"metadata"}, {"spec"}}))
+		})
+		It("should stop on errors", func() {
+			errStop := errors.New("stop")
+			n := 0
+			Expect(path.Walk(doc, func(p path.Path, v any) error {
+				n++
+				if p.String() == "metadata.labels" {
+					return errStop
+				}
+				return nil
+			})).To(MatchError(errStop))
+			Expect(n).To(Equal(4))
+		})
+	})
+})
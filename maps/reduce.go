@@ -0,0 +1,109 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps
+
+import (
+	"cmp"
+
+	"github.com/sap/go-generics/slices"
+)
+
+// Return minimal value of map of orderable values.
+// Floating point NaN values are considered smaller than other values (as for slices.Sort()).
+// If the input is nil or empty, the zero value and false will be returned.
+func Min[K comparable, V slices.Orderable](m map[K]V) (V, bool) {
+	return slices.Min(Values(m))
+}
+
+// Return maximal value of map of orderable values.
+// Floating point NaN values are considered smaller than other values (as for slices.Sort()).
+// If the input is nil or empty, the zero value and false will be returned.
+func Max[K comparable, V slices.Orderable](m map[K]V) (V, bool) {
+	return slices.Max(Values(m))
+}
+
+// Return minimal and maximal value of map of orderable values.
+// Floating point NaN values are considered smaller than other values (as for slices.Sort()).
+// If the input is nil or empty, zero values and false will be returned.
+func MinMax[K comparable, V slices.Orderable](m map[K]V) (V, V, bool) {
+	return slices.MinMax(Values(m))
+}
+
+// Return value of map with minimal key, as returned by given mapper function (applied to the values).
+// If there are multiple such values, it is unspecified which one is returned.
+// If the input is nil or empty, the zero value and false will be returned.
+func MinBy[K comparable, V any, W slices.Orderable](m map[K]V, f func(V) W) (V, bool) {
+	return slices.MinBy(Values(m), f)
+}
+
+// Return value of map with maximal key, as returned by given mapper function (applied to the values).
+// If there are multiple such values, it is unspecified which one is returned.
+// If the input is nil or empty, the zero value and false will be returned.
+func MaxBy[K comparable, V any, W slices.Orderable](m map[K]V, f func(V) W) (V, bool) {
+	return slices.MaxBy(Values(m), f)
+}
+
+// Return key of map having the minimal value.
+// If there are multiple such keys, it is unspecified which one is returned.
+// If the input is nil or empty, the zero value and false will be returned.
+func ArgMin[K comparable, V slices.Orderable](m map[K]V) (K, bool) {
+	return argBy(m, -1)
+}
+
+// Return key of map having the maximal value.
+// If there are multiple such keys, it is unspecified which one is returned.
+// If the input is nil or empty, the zero value and false will be returned.
+func ArgMax[K comparable, V slices.Orderable](m map[K]V) (K, bool) {
+	return argBy(m, 1)
+}
+
+// Return sum of all values of map.
+// If the input is nil or empty, zero will be returned. Integer overflows are not detected.
+func Sum[K comparable, V slices.Numeric](m map[K]V) (r V) {
+	for _, v := range m {
+		r += v
+	}
+	return
+}
+
+// Return product of all values of map.
+// If the input is nil or empty, one will be returned (the empty product). Integer overflows are not detected.
+func Product[K comparable, V slices.Numeric](m map[K]V) (r V) {
+	r = 1
+	for _, v := range m {
+		r *= v
+	}
+	return
+}
+
+// Return arithmetic mean of all values of map (calculated in floating point arithmetic).
+// If the input is nil or empty, zero and false will be returned.
+func Mean[K comparable, V slices.Numeric](m map[K]V) (float64, bool) {
+	return slices.Mean(Values(m))
+}
+
+// Return median of all values of map (as for slices.Median()).
+// If the input is nil or empty, zero and false will be returned.
+func Median[K comparable, V slices.Numeric](m map[K]V) (float64, bool) {
+	return slices.Median(Values(m))
+}
+
+// Return p-th percentile of all values of map (as for slices.Percentile()).
+// If the input is nil or empty, zero and false will be returned. Panics if p is not within [0, 100].
+func Percentile[K comparable, V slices.Numeric](m map[K]V, p float64) (float64, bool) {
+	return slices.Percentile(Values(m), p)
+}
+
+// Return a key with minimal (if sign is -1) or maximal (if sign is 1) value.
+func argBy[K comparable, V slices.Orderable](m map[K]V, sign int) (k K, ok bool) {
+	var w V
+	for l, v := range m {
+		if !ok || cmp.Compare(v, w)*sign > 0 {
+			k, w, ok = l, v, true
+		}
+	}
+	return
+}
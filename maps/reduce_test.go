@@ -0,0 +1,91 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maps_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/maps"
+)
+
+var _ = Describe("maps (reductions)", func() {
+	var nilMap map[string]int
+	var mapA map[string]int
+
+	BeforeEach(func() {
+		mapA = map[string]int{"a": 3, "b": 1, "c": 4, "d": 2}
+	})
+
+	Context("with a nil map", func() {
+		It("should return zero values and false, or the empty sum and product", func() {
+			v, ok := maps.Min(nilMap)
+			Expect(v).To(Equal(0))
+			Expect(ok).To(BeFalse())
+			v, ok = maps.Max(nilMap)
+			Expect(v).To(Equal(0))
+			Expect(ok).To(BeFalse())
+			v, w, ok := maps.MinMax(nilMap)
+			Expect(v).To(Equal(0))
+			Expect(w).To(Equal(0))
+			Expect(ok).To(BeFalse())
+			v, ok = maps.MinBy(nilMap, func(v int) int { return -v })
+			Expect(v).To(Equal(0))
+			Expect(ok).To(BeFalse())
+			k, ok := maps.ArgMin(nilMap)
+			Expect(k).To(Equal(""))
+			Expect(ok).To(BeFalse())
+			k, ok = maps.ArgMax(nilMap)
+			Expect(k).To(Equal(""))
+			Expect(ok).To(BeFalse())
+			Expect(maps.Sum(nilMap)).To(Equal(0))
+			Expect(maps.Product(nilMap)).To(Equal(1))
+			x, ok := maps.Mean(nilMap)
+			Expect(x).To(Equal(0.0))
+			Expect(ok).To(BeFalse())
+			x, ok = maps.Median(nilMap)
+			Expect(x).To(Equal(0.0))
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("with a non-empty map", func() {
+		It("should reduce the values", func() {
+			v, ok := maps.Min(mapA)
+			Expect(v).To(Equal(1))
+			Expect(ok).To(BeTrue())
+			v, ok = maps.Max(mapA)
+			Expect(v).To(Equal(4))
+			Expect(ok).To(BeTrue())
+			v, w, ok := maps.MinMax(mapA)
+			Expect(v).To(Equal(1))
+			Expect(w).To(Equal(4))
+			Expect(ok).To(BeTrue())
+			v, ok = maps.MinBy(mapA, func(v int) int { return -v })
+			Expect(v).To(Equal(4))
+			Expect(ok).To(BeTrue())
+			v, ok = maps.MaxBy(mapA, func(v int) int { return -v })
+			Expect(v).To(Equal(1))
+			Expect(ok).To(BeTrue())
+			k, ok := maps.ArgMin(mapA)
+			Expect(k).To(Equal("b"))
+			Expect(ok).To(BeTrue())
+			k, ok = maps.ArgMax(mapA)
+			Expect(k).To(Equal("c"))
+			Expect(ok).To(BeTrue())
+			Expect(maps.Sum(mapA)).To(Equal(10))
+			Expect(maps.Product(mapA)).To(Equal(24))
+			x, ok := maps.Mean(mapA)
+			Expect(x).To(Equal(2.5))
+			Expect(ok).To(BeTrue())
+			x, ok = maps.Median(mapA)
+			Expect(x).To(Equal(2.5))
+			Expect(ok).To(BeTrue())
+			x, ok = maps.Percentile(mapA, 100)
+			Expect(x).To(Equal(4.0))
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,156 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ordering
+
+import (
+	"cmp"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sap/go-generics/slices"
+)
+
+// Three-way comparator function.
+// A comparator c(x,y) returns a negative number if x is smaller than y, a positive number if x is larger than y,
+// and zero if x and y are considered equal; that is, it follows the convention of the standard library (e.g. cmp.Compare()).
+// Comparators can be passed to all functions accepting a func(x, y T) int, such as slices.SortFunc().
+type Comparator[T any] func(x, y T) int
+
+// Natural ordering of orderable elements.
+// Floating point NaN values are considered smaller than other values, and equal to each other.
+func Natural[T slices.Orderable](x, y T) int {
+	return cmp.Compare(x, y)
+}
+
+// Return comparator inverting the order defined by given comparator.
+func Reverse[T any](c Comparator[T]) Comparator[T] {
+	return func(x, y T) int {
+		return c(y, x)
+	}
+}
+
+// Return comparator ordering by given comparator first, and then (for elements considered equal) by the further comparators,
+// in the given order.
+func Then[T any](c Comparator[T], d ...Comparator[T]) Comparator[T] {
+	return func(x, y T) int {
+		if r := c(x, y); r != 0 {
+			return r
+		}
+		for _, c := range d {
+			if r := c(x, y); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// Return comparator ordering by given comparator first, and then (for elements considered equal) by the natural order
+// of the keys returned by f.
+func ThenBy[T any, K slices.Orderable](c Comparator[T], f func(T) K) Comparator[T] {
+	return Then(c, By(f))
+}
+
+// Return comparator ordering elements by the natural order of the keys returned by f.
+func By[T any, K slices.Orderable](f func(T) K) Comparator[T] {
+	return func(x, y T) int {
+		return cmp.Compare(f(x), f(y))
+	}
+}
+
+// Return comparator ordering elements by the keys returned by f, where keys are compared by given comparator.
+func ByFunc[T any, K any](f func(T) K, c Comparator[K]) Comparator[T] {
+	return func(x, y T) int {
+		return c(f(x), f(y))
+	}
+}
+
+// Return comparator for pointers, ordering nil before all other pointers;
+// non-nil pointers are compared by applying given comparator to the values they point to.
+func NilsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return func(x, y *T) int {
+		switch {
+		case x == nil && y == nil:
+			return 0
+		case x == nil:
+			return -1
+		case y == nil:
+			return 1
+		default:
+			return c(*x, *y)
+		}
+	}
+}
+
+// Return comparator for pointers, ordering nil after all other pointers;
+// non-nil pointers are compared by applying given comparator to the values they point to.
+func NilsLast[T any](c Comparator[T]) Comparator[*T] {
+	return func(x, y *T) int {
+		switch {
+		case x == nil && y == nil:
+			return 0
+		case x == nil:
+			return 1
+		case y == nil:
+			return -1
+		default:
+			return c(*x, *y)
+		}
+	}
+}
+
+// Compare strings lexicographically (rune by rune), ignoring case (using simple Unicode case folding).
+func CaseInsensitive(x, y string) int {
+	for x != "" && y != "" {
+		r, n := utf8.DecodeRuneInString(x)
+		s, m := utf8.DecodeRuneInString(y)
+		x, y = x[n:], y[m:]
+		if r == s {
+			continue
+		}
+		if r, s = fold(r), fold(s); r != s {
+			return cmp.Compare(r, s)
+		}
+	}
+	return cmp.Compare(len(x), len(y))
+}
+
+// Return comparator from a "greater than" function, as used by slices.SortBy().
+// The function f(x,y) must return true if x is larger than y, and false otherwise.
+func FromGreater[T any](f func(x, y T) bool) Comparator[T] {
+	return func(x, y T) int {
+		if f(x, y) {
+			return 1
+		}
+		if f(y, x) {
+			return -1
+		}
+		return 0
+	}
+}
+
+// Return "greater than" function from given comparator, as accepted by slices.SortBy() and similar functions.
+func Greater[T any](c Comparator[T]) func(x, y T) bool {
+	return func(x, y T) bool {
+		return c(x, y) > 0
+	}
+}
+
+// Return "less than" function from given comparator.
+func Less[T any](c Comparator[T]) func(x, y T) bool {
+	return func(x, y T) bool {
+		return c(x, y) < 0
+	}
+}
+
+// Map rune to the smallest rune of its case folding orbit.
+func fold(r rune) rune {
+	m := r
+	for s := unicode.SimpleFold(r); s != r; s = unicode.SimpleFold(s) {
+		m = min(m, s)
+	}
+	return m
+}
@@ -0,0 +1,124 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ordering_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/ordering"
+	"github.com/sap/go-generics/slices"
+)
+
+func TestOrdering(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Ordering Suite")
+}
+
+type person struct {
+	name string
+	age  int
+}
+
+var _ = Describe("ordering", func() {
+	var people []person
+
+	BeforeEach(func() {
+		people = []person{{"carol", 30}, {"alice", 40}, {"bob", 30}, {"Dave", 20}, {"alice", 20}}
+	})
+
+	Describe("tests for Natural()", func() {
+		It("should follow the natural order", func() {
+			Expect(ordering.Natural(1, 2)).To(BeNumerically("<", 0))
+			Expect(ordering.Natural("b", "a")).To(BeNumerically(">", 0))
+			Expect(ordering.Natural(3.0, 3.0)).To(Equal(0))
+		})
+		It("should order NaN before other values", func() {
+			Expect(ordering.Natural(math.NaN(), math.Inf(-1))).To(BeNumerically("<", 0))
+			Expect(ordering.Natural(math.NaN(), math.NaN())).To(Equal(0))
+		})
+	})
+
+	Describe("tests for Reverse()", func() {
+		It("should invert the order", func() {
+			c := ordering.Reverse(ordering.Natural[int])
+			Expect(c(1, 2)).To(BeNumerically(">", 0))
+			Expect(c(2, 1)).To(BeNumerically("<", 0))
+			Expect(c(2, 2)).To(Equal(0))
+		})
+	})
+
+	Describe("tests for By(), Then() and ThenBy()", func() {
+		It("should order by the first key, then by the further keys", func() {
+			c := ordering.ThenBy(ordering.By(func(p person) int { return p.age }), func(p person) string { return p.name })
+			Expect(slices.SortFunc(people, c)).To(Equal([]person{{"Dave", 20}, {"alice", 20}, {"bob", 30}, {"carol", 30}, {"alice", 40}}))
+		})
+		It("should combine multiple comparators", func() {
+			c := ordering.Then(
+				ordering.ByFunc(func(p person) string { return p.name }, ordering.CaseInsensitive),
+				ordering.Reverse(ordering.By(func(p person) int { return p.age })),
+			)
+			Expect(slices.SortFunc(people, c)).To(Equal([]person{{"alice", 40}, {"alice", 20}, {"bob", 30}, {"carol", 30}, {"Dave", 20}}))
+		})
+		It("should return zero if all comparators consider the elements equal", func() {
+			c := ordering.Then(ordering.By(func(p person) int { return p.age }))
+			Expect(c(person{"x", 1}, person{"y", 1})).To(Equal(0))
+		})
+	})
+
+	Describe("tests for NilsFirst() and NilsLast()", func() {
+		var one, two int
+		var s []*int
+
+		BeforeEach(func() {
+			one, two = 1, 2
+			s = []*int{&two, nil, &one, nil}
+		})
+
+		It("should order nil pointers first", func() {
+			Expect(slices.SortFunc(s, ordering.NilsFirst(ordering.Natural[int]))).To(Equal([]*int{nil, nil, &one, &two}))
+		})
+		It("should order nil pointers last", func() {
+			Expect(slices.SortFunc(s, ordering.NilsLast(ordering.Natural[int]))).To(Equal([]*int{&one, &two, nil, nil}))
+		})
+	})
+
+	Describe("tests for CaseInsensitive()", func() {
+		It("should compare strings ignoring case", func() {
+			Expect(ordering.CaseInsensitive("Hello", "hELLO")).To(Equal(0))
+			Expect(ordering.CaseInsensitive("straße", "STRASSE")).NotTo(Equal(0))
+			Expect(ordering.CaseInsensitive("Äpfel", "äPFEL")).To(Equal(0))
+			Expect(ordering.CaseInsensitive("a", "B")).To(BeNumerically("<", 0))
+			Expect(ordering.CaseInsensitive("B", "a")).To(BeNumerically(">", 0))
+			Expect(ordering.CaseInsensitive("ab", "A")).To(BeNumerically(">", 0))
+			Expect(ordering.CaseInsensitive("", "a")).To(BeNumerically("<", 0))
+		})
+		It("should be consistent with comparing lowercase ASCII strings", func() {
+			s := []string{"b", "A", "c", "aB", "Ab", "C", ""}
+			for _, x := range s {
+				for _, y := range s {
+					Expect(ordering.CaseInsensitive(x, y)).To(Equal(strings.Compare(strings.ToLower(x), strings.ToLower(y))))
+				}
+			}
+		})
+	})
+
+	Describe("tests for FromGreater(), Greater() and Less()", func() {
+		It("should convert between comparators and boolean functions", func() {
+			c := ordering.FromGreater(func(x, y int) bool { return x > y })
+			Expect(c(1, 2)).To(Equal(-1))
+			Expect(c(2, 1)).To(Equal(1))
+			Expect(c(2, 2)).To(Equal(0))
+			Expect(ordering.Greater(c)(2, 1)).To(BeTrue())
+			Expect(ordering.Greater(c)(1, 1)).To(BeFalse())
+			Expect(ordering.Less(c)(1, 2)).To(BeTrue())
+			Expect(slices.SortBy([]int{3, 1, 2}, ordering.Greater(ordering.Reverse(ordering.Natural[int])))).To(Equal([]int{3, 2, 1}))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pairs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Marshal pair as two-element JSON array.
+func (p Pair[S, T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.X, p.Y})
+}
+
+// Unmarshal pair from two-element JSON array (or null, resulting in the zero value).
+// Fails if the array has a different number of elements; in that case, the pair remains unchanged.
+func (p *Pair[S, T]) UnmarshalJSON(data []byte) error {
+	var q Pair[S, T]
+	if err := unmarshalTuple(data, &q.X, &q.Y); err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+// Marshal triple as three-element JSON array.
+func (t Triple[S, T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.X, t.Y, t.Z})
+}
+
+// Unmarshal triple from three-element JSON array (or null, resulting in the zero value).
+// Fails if the array has a different number of elements; in that case, the triple remains unchanged.
+func (t *Triple[S, T, U]) UnmarshalJSON(data []byte) error {
+	var u Triple[S, T, U]
+	if err := unmarshalTuple(data, &u.X, &u.Y, &u.Z); err != nil {
+		return err
+	}
+	*t = u
+	return nil
+}
+
+// Marshal quadruple as four-element JSON array.
+func (q Quad[S, T, U, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{q.X, q.Y, q.Z, q.W})
+}
+
+// Unmarshal quadruple from four-element JSON array (or null, resulting in the zero value).
+// Fails if the array has a different number of elements; in that case, the quadruple remains unchanged.
+func (q *Quad[S, T, U, V]) UnmarshalJSON(data []byte) error {
+	var r Quad[S, T, U, V]
+	if err := unmarshalTuple(data, &r.X, &r.Y, &r.Z, &r.W); err != nil {
+		return err
+	}
+	*q = r
+	return nil
+}
+
+// Unmarshal JSON array into the given targets (which must have the same number of elements as the array);
+// null leaves the targets unchanged.
+func unmarshalTuple(data []byte, targets ...any) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if elements == nil {
+		return nil
+	}
+	if len(elements) != len(targets) {
+		return fmt.Errorf("expected JSON array with %d elements, got %d elements", len(targets), len(elements))
+	}
+	for i, element := range elements {
+		if err := json.Unmarshal(element, targets[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
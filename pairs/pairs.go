@@ -5,13 +5,119 @@ SPDX-License-Identifier: Apache-2.0
 
 package pairs
 
+import (
+	"cmp"
+	"fmt"
+)
+
 // Pair.
+// Pairs are comparable (and can therefore be used as map keys) if both components are comparable.
 type Pair[S any, T any] struct {
 	X S
 	Y T
 }
 
-// Create new pair.
+// Triple.
+type Triple[S any, T any, U any] struct {
+	X S
+	Y T
+	Z U
+}
+
+// Quadruple.
+type Quad[S any, T any, U any, V any] struct {
+	X S
+	Y T
+	Z U
+	W V
+}
+
+// Create new pair (returning a pointer).
 func New[S any, T any](x S, y T) *Pair[S, T] {
 	return &Pair[S, T]{X: x, Y: y}
 }
+
+// Create pair (returning a value).
+func Make[S any, T any](x S, y T) Pair[S, T] {
+	return Pair[S, T]{X: x, Y: y}
+}
+
+// Create triple.
+func MakeTriple[S any, T any, U any](x S, y T, z U) Triple[S, T, U] {
+	return Triple[S, T, U]{X: x, Y: y, Z: z}
+}
+
+// Create quadruple.
+func MakeQuad[S any, T any, U any, V any](x S, y T, z U, w V) Quad[S, T, U, V] {
+	return Quad[S, T, U, V]{X: x, Y: y, Z: z, W: w}
+}
+
+// Return the components of the pair.
+func (p Pair[S, T]) Values() (S, T) {
+	return p.X, p.Y
+}
+
+// Return pair with swapped components.
+func (p Pair[S, T]) Swap() Pair[T, S] {
+	return Pair[T, S]{X: p.Y, Y: p.X}
+}
+
+// Return string representation of the pair, such as (1, a).
+func (p Pair[S, T]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.X, p.Y)
+}
+
+// Return the components of the triple.
+func (t Triple[S, T, U]) Values() (S, T, U) {
+	return t.X, t.Y, t.Z
+}
+
+// Return string representation of the triple, such as (1, a, true).
+func (t Triple[S, T, U]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.X, t.Y, t.Z)
+}
+
+// Return the components of the quadruple.
+func (q Quad[S, T, U, V]) Values() (S, T, U, V) {
+	return q.X, q.Y, q.Z, q.W
+}
+
+// Return string representation of the quadruple, such as (1, a, true, 2.5).
+func (q Quad[S, T, U, V]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", q.X, q.Y, q.Z, q.W)
+}
+
+// Compare two pairs of orderable components lexicographically (that is, by X first, and then by Y).
+// Returns a negative number if p is smaller than q, a positive number if p is larger than q, and zero otherwise
+// (as cmp.Compare(); in particular, floating point NaN values are considered smaller than other values).
+func Compare[S cmp.Ordered, T cmp.Ordered](p Pair[S, T], q Pair[S, T]) int {
+	if r := cmp.Compare(p.X, q.X); r != 0 {
+		return r
+	}
+	return cmp.Compare(p.Y, q.Y)
+}
+
+// Compare two triples of orderable components lexicographically (as Compare()).
+func CompareTriple[S cmp.Ordered, T cmp.Ordered, U cmp.Ordered](t Triple[S, T, U], u Triple[S, T, U]) int {
+	if r := cmp.Compare(t.X, u.X); r != 0 {
+		return r
+	}
+	if r := cmp.Compare(t.Y, u.Y); r != 0 {
+		return r
+	}
+	return cmp.Compare(t.Z, u.Z)
+}
+
+// Compare two quadruples of orderable components lexicographically (as Compare()).
+func CompareQuad[S cmp.Ordered, T cmp.Ordered, U cmp.Ordered, V cmp.Ordered](q Quad[S, T, U, V], r Quad[S, T, U, V]) int {
+	if c := cmp.Compare(q.X, r.X); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(q.Y, r.Y); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(q.Z, r.Z); c != 0 {
+		return c
+	}
+	return cmp.Compare(q.W, r.W)
+}
@@ -0,0 +1,119 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pairs_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/pairs"
+	"github.com/sap/go-generics/slices"
+)
+
+func TestPairs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Pairs Suite")
+}
+
+var _ = Describe("pairs", func() {
+	Describe("tests for constructors", func() {
+		It("should create pairs, triples and quadruples", func() {
+			Expect(pairs.New(1, "a")).To(Equal(&pairs.Pair[int, string]{X: 1, Y: "a"}))
+			Expect(pairs.Make(1, "a")).To(Equal(pairs.Pair[int, string]{X: 1, Y: "a"}))
+			Expect(pairs.MakeTriple(1, "a", true)).To(Equal(pairs.Triple[int, string, bool]{X: 1, Y: "a", Z: true}))
+			Expect(pairs.MakeQuad(1, "a", true, 2.5)).To(Equal(pairs.Quad[int, string, bool, float64]{X: 1, Y: "a", Z: true, W: 2.5}))
+		})
+	})
+
+	Describe("tests for Values() and Swap()", func() {
+		It("should return the components", func() {
+			x, y := pairs.Make(1, "a").Values()
+			Expect(x).To(Equal(1))
+			Expect(y).To(Equal("a"))
+			x, y, z := pairs.MakeTriple(1, "a", true).Values()
+			Expect([]any{x, y, z}).To(Equal([]any{1, "a", true}))
+			x, y, z, w := pairs.MakeQuad(1, "a", true, 2.5).Values()
+			Expect([]any{x, y, z, w}).To(Equal([]any{1, "a", true, 2.5}))
+		})
+		It("should swap the components", func() {
+			Expect(pairs.Make(1, "a").Swap()).To(Equal(pairs.Make("a", 1)))
+		})
+	})
+
+	Describe("tests for String()", func() {
+		It("should return a string representation", func() {
+			Expect(pairs.Make(1, "a").String()).To(Equal("(1, a)"))
+			Expect(fmt.Sprint(pairs.MakeTriple(1, "a", true))).To(Equal("(1, a, true)"))
+			Expect(fmt.Sprint(pairs.MakeQuad(1, "a", true, 2.5))).To(Equal("(1, a, true, 2.5)"))
+		})
+	})
+
+	Describe("tests for Compare()", func() {
+		It("should compare lexicographically", func() {
+			Expect(pairs.Compare(pairs.Make(1, "b"), pairs.Make(2, "a"))).To(Equal(-1))
+			Expect(pairs.Compare(pairs.Make(1, "b"), pairs.Make(1, "a"))).To(Equal(1))
+			Expect(pairs.Compare(pairs.Make(1, "a"), pairs.Make(1, "a"))).To(Equal(0))
+			Expect(pairs.Compare(pairs.Make(math.NaN(), 1), pairs.Make(0.0, 0))).To(Equal(-1))
+			Expect(pairs.CompareTriple(pairs.MakeTriple(1, 2, 3), pairs.MakeTriple(1, 2, 4))).To(Equal(-1))
+			Expect(pairs.CompareTriple(pairs.MakeTriple(1, 3, 0), pairs.MakeTriple(1, 2, 4))).To(Equal(1))
+			Expect(pairs.CompareQuad(pairs.MakeQuad(1, 2, 3, 4), pairs.MakeQuad(1, 2, 3, 4))).To(Equal(0))
+			Expect(pairs.CompareQuad(pairs.MakeQuad(1, 2, 3, 5), pairs.MakeQuad(1, 2, 3, 4))).To(Equal(1))
+		})
+		It("should be usable for sorting", func() {
+			s := []pairs.Pair[string, int]{{X: "b", Y: 1}, {X: "a", Y: 2}, {X: "a", Y: 1}}
+			Expect(slices.SortFunc(s, pairs.Compare)).To(Equal([]pairs.Pair[string, int]{{X: "a", Y: 1}, {X: "a", Y: 2}, {X: "b", Y: 1}}))
+		})
+	})
+
+	Describe("tests for usage as map keys", func() {
+		It("should work", func() {
+			m := map[pairs.Pair[string, int]]bool{pairs.Make("a", 1): true}
+			Expect(m[pairs.Make("a", 1)]).To(BeTrue())
+			Expect(m[pairs.Make("a", 2)]).To(BeFalse())
+		})
+	})
+
+	Describe("tests for JSON encoding", func() {
+		It("should marshal as array", func() {
+			Expect(json.Marshal(pairs.Make(1, "a"))).To(MatchJSON(`[1,"a"]`))
+			Expect(json.Marshal(pairs.New(1, "a"))).To(MatchJSON(`[1,"a"]`))
+			Expect(json.Marshal(pairs.MakeTriple(1, "a", true))).To(MatchJSON(`[1,"a",true]`))
+			Expect(json.Marshal(pairs.MakeQuad(1, "a", true, []int{2}))).To(MatchJSON(`[1,"a",true,[2]]`))
+			Expect(json.Marshal(map[string]pairs.Pair[int, int]{"x": pairs.Make(1, 2)})).To(MatchJSON(`{"x":[1,2]}`))
+		})
+		It("should unmarshal from array", func() {
+			var p pairs.Pair[int, string]
+			Expect(json.Unmarshal([]byte(`[1,"a"]`), &p)).To(Succeed())
+			Expect(p).To(Equal(pairs.Make(1, "a")))
+			var t pairs.Triple[int, string, bool]
+			Expect(json.Unmarshal([]byte(`[1,"a",true]`), &t)).To(Succeed())
+			Expect(t).To(Equal(pairs.MakeTriple(1, "a", true)))
+			var q pairs.Quad[int, string, bool, []int]
+			Expect(json.Unmarshal([]byte(`[1,"a",true,[2]]`), &q)).To(Succeed())
+			Expect(q).To(Equal(pairs.MakeQuad(1, "a", true, []int{2})))
+			var s []pairs.Pair[string, int]
+			Expect(json.Unmarshal([]byte(`[["a",1],["b",2]]`), &s)).To(Succeed())
+			Expect(s).To(Equal([]pairs.Pair[string, int]{pairs.Make("a", 1), pairs.Make("b", 2)}))
+		})
+		It("should unmarshal null to the zero value", func() {
+			p := pairs.Make(1, "a")
+			Expect(json.Unmarshal([]byte(`null`), &p)).To(Succeed())
+			Expect(p).To(Equal(pairs.Pair[int, string]{}))
+		})
+		It("should fail on invalid input, leaving the target unchanged", func() {
+			p := pairs.Make(1, "a")
+			Expect(json.Unmarshal([]byte(`[2]`), &p)).To(MatchError("expected JSON array with 2 elements, got 1 elements"))
+			Expect(json.Unmarshal([]byte(`[2,"b",3]`), &p)).NotTo(Succeed())
+			Expect(json.Unmarshal([]byte(`[2,3]`), &p)).NotTo(Succeed())
+			Expect(json.Unmarshal([]byte(`{"X":2,"Y":"b"}`), &p)).NotTo(Succeed())
+			Expect(p).To(Equal(pairs.Make(1, "a")))
+		})
+	})
+})
@@ -0,0 +1,184 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package parallel
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Internal cancellation cause, signaling that the result is known, and remaining elements need not be processed.
+var errDone = errors.New("done")
+
+// Collect (map) slice through given function, processing up to limit elements concurrently.
+// If limit is not positive, runtime.GOMAXPROCS(0) is used as limit.
+// The order of the result corresponds to the order of the input slice. Processing stops at the first error returned by f,
+// or when the context is done; in that case the error (respectively the context's error) is returned.
+// The context passed to f is cancelled as soon as processing stops.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty slice.
+func Collect[S any, T any](ctx context.Context, s []S, limit int, f func(context.Context, S) (T, error)) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	r := make([]T, len(s))
+	err := run(ctx, len(s), limit, func(ctx context.Context, i int) (bool, error) {
+		var err error
+		r[i], err = f(ctx, s[i])
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Select slice by given function, processing up to limit elements concurrently.
+// If limit is not positive, runtime.GOMAXPROCS(0) is used as limit.
+// The order of the result corresponds to the order of the input slice. Processing stops at the first error returned by f,
+// or when the context is done; in that case the error (respectively the context's error) is returned.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty slice.
+func Select[T any](ctx context.Context, s []T, limit int, f func(context.Context, T) (bool, error)) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	selected := make([]bool, len(s))
+	err := run(ctx, len(s), limit, func(ctx context.Context, i int) (bool, error) {
+		var err error
+		selected[i], err = f(ctx, s[i])
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := make([]T, 0)
+	for i, x := range s {
+		if selected[i] {
+			r = append(r, x)
+		}
+	}
+	return r, nil
+}
+
+// Call given function for each element of the slice, processing up to limit elements concurrently.
+// If limit is not positive, runtime.GOMAXPROCS(0) is used as limit.
+// Processing stops at the first error returned by f, or when the context is done; in that case the error
+// (respectively the context's error) is returned.
+func ForEach[T any](ctx context.Context, s []T, limit int, f func(context.Context, T) error) error {
+	return run(ctx, len(s), limit, func(ctx context.Context, i int) (bool, error) {
+		return false, f(ctx, s[i])
+	})
+}
+
+// Reduce slice, by mapping its elements through f (processing up to limit elements concurrently), and then
+// combining the mapped values with the given initial value, sequentially and in input order.
+// If limit is not positive, runtime.GOMAXPROCS(0) is used as limit.
+// Processing stops at the first error returned by f, or when the context is done; in that case the error
+// (respectively the context's error) is returned.
+// If the input is nil or empty, the initial value will be returned.
+func Reduce[T any, U any, V any](ctx context.Context, s []T, limit int, init V, f func(context.Context, T) (U, error), combine func(V, U) V) (V, error) {
+	u, err := Collect(ctx, s, limit, f)
+	if err != nil {
+		var v V
+		return v, err
+	}
+	r := init
+	for _, x := range u {
+		r = combine(r, x)
+	}
+	return r, nil
+}
+
+// Report whether the given boolean function evaluates to true for at least one element of the given slice,
+// processing up to limit elements concurrently.
+// If limit is not positive, runtime.GOMAXPROCS(0) is used as limit.
+// Processing stops as soon as the result is known (and the context passed to f is cancelled), at the first error
+// returned by f, or when the context is done; in the latter two cases the error (respectively the context's error) is returned.
+// Returns false for nil or empty slices.
+func Any[T any](ctx context.Context, s []T, limit int, f func(context.Context, T) (bool, error)) (bool, error) {
+	var found atomic.Bool
+	err := run(ctx, len(s), limit, func(ctx context.Context, i int) (bool, error) {
+		ok, err := f(ctx, s[i])
+		if err == nil && ok {
+			found.Store(true)
+		}
+		return ok, err
+	})
+	if err != nil {
+		return false, err
+	}
+	return found.Load(), nil
+}
+
+// Report whether the given boolean function evaluates to true for all elements of the given slice,
+// processing up to limit elements concurrently.
+// If limit is not positive, runtime.GOMAXPROCS(0) is used as limit.
+// Processing stops as soon as the result is known (and the context passed to f is cancelled), at the first error
+// returned by f, or when the context is done; in the latter two cases the error (respectively the context's error) is returned.
+// Returns true for nil or empty slices.
+func All[T any](ctx context.Context, s []T, limit int, f func(context.Context, T) (bool, error)) (bool, error) {
+	found, err := Any(ctx, s, limit, func(ctx context.Context, x T) (bool, error) {
+		ok, err := f(ctx, x)
+		return !ok, err
+	})
+	if err != nil {
+		return false, err
+	}
+	return !found, nil
+}
+
+// Process indices 0, ..., n-1 by given function, using up to limit workers.
+// If f returns true, processing of further indices stops (without error).
+func run(ctx context.Context, n int, limit int, f func(context.Context, int) (bool, error)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	if limit <= 0 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+	parentCtx := ctx
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var next, completed atomic.Int64
+	var wg sync.WaitGroup
+	for range min(limit, n) {
+		wg.Go(func() {
+			for ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				done, err := f(ctx, i)
+				if err != nil {
+					cancel(err)
+					return
+				}
+				completed.Add(1)
+				if done {
+					cancel(errDone)
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	cause := context.Cause(ctx)
+	switch {
+	case cause == nil || cause == errDone || completed.Load() == int64(n):
+		return nil
+	case parentCtx.Err() != nil && cause == context.Cause(parentCtx):
+		return parentCtx.Err()
+	default:
+		return cause
+	}
+}
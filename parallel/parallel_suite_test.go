@@ -0,0 +1,211 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package parallel_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/parallel"
+)
+
+func TestParallel(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Parallel Suite")
+}
+
+var _ = Describe("parallel", func() {
+	var ctx context.Context
+	var nilSlice []int
+	var emptySlice []int
+	var sliceD []int
+	var largeSlice []int
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		nilSlice = nil
+		emptySlice = []int{}
+		sliceD = []int{9, 6, 5, 6, 3, 7, 7, 1, 2, 8}
+		largeSlice = make([]int, 1000)
+		for i := range largeSlice {
+			largeSlice[i] = i
+		}
+	})
+
+	Describe("tests for Collect()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(parallel.Collect(ctx, nilSlice, 2, func(context.Context, int) (int, error) { return 0, nil })).To(BeNil())
+			})
+		})
+		Context("with an empty slice", func() {
+			It("should return an empty slice", func() {
+				Expect(parallel.Collect(ctx, emptySlice, 2, func(context.Context, int) (int, error) { return 0, nil })).To(Equal([]int{}))
+			})
+		})
+		Context("with a more complex slice", func() {
+			It("should return the mapped elements in order", func() {
+				f := func(_ context.Context, x int) (int, error) {
+					time.Sleep(time.Duration(x) * time.Millisecond)
+					return 2 * x, nil
+				}
+				Expect(parallel.Collect(ctx, sliceD, 4, f)).To(Equal([]int{18, 12, 10, 12, 6, 14, 14, 2, 4, 16}))
+				Expect(parallel.Collect(ctx, sliceD, 0, f)).To(Equal([]int{18, 12, 10, 12, 6, 14, 14, 2, 4, 16}))
+			})
+		})
+		Context("with a bounded number of workers", func() {
+			It("should not exceed the limit", func() {
+				var active, peak atomic.Int64
+				f := func(_ context.Context, x int) (int, error) {
+					n := active.Add(1)
+					for {
+						p := peak.Load()
+						if n <= p || peak.CompareAndSwap(p, n) {
+							break
+						}
+					}
+					time.Sleep(time.Millisecond)
+					active.Add(-1)
+					return x, nil
+				}
+				Expect(parallel.Collect(ctx, largeSlice[:100], 3, f)).To(Equal(largeSlice[:100]))
+				Expect(peak.Load()).To(BeNumerically("<=", 3))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should stop at the first error", func() {
+				var calls atomic.Int64
+				f := func(ctx context.Context, x int) (int, error) {
+					calls.Add(1)
+					if x == 10 {
+						return 0, errors.New("failed")
+					}
+					time.Sleep(time.Millisecond)
+					return x, nil
+				}
+				r, err := parallel.Collect(ctx, largeSlice, 2, f)
+				Expect(err).To(MatchError("failed"))
+				Expect(r).To(BeNil())
+				Expect(calls.Load()).To(BeNumerically("<", 100))
+			})
+		})
+		Context("with a cancelled context", func() {
+			It("should return the context's error", func() {
+				ctx, cancel := context.WithCancel(ctx)
+				f := func(_ context.Context, x int) (int, error) {
+					if x == 10 {
+						cancel()
+					}
+					return x, nil
+				}
+				_, err := parallel.Collect(ctx, largeSlice, 2, f)
+				Expect(err).To(MatchError(context.Canceled))
+				_, err = parallel.Collect(ctx, emptySlice, 2, f)
+				Expect(err).To(MatchError(context.Canceled))
+			})
+		})
+	})
+
+	Describe("tests for Select()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(parallel.Select(ctx, nilSlice, 2, func(context.Context, int) (bool, error) { return true, nil })).To(BeNil())
+			})
+		})
+		Context("with a more complex slice", func() {
+			It("should return the selected elements in order", func() {
+				f := func(_ context.Context, x int) (bool, error) {
+					return x%2 != 0, nil
+				}
+				Expect(parallel.Select(ctx, sliceD, 3, f)).To(Equal([]int{9, 5, 3, 7, 7, 1}))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				_, err := parallel.Select(ctx, sliceD, 3, func(context.Context, int) (bool, error) { return false, errors.New("failed") })
+				Expect(err).To(MatchError("failed"))
+			})
+		})
+	})
+
+	Describe("tests for ForEach()", func() {
+		It("should process all elements", func() {
+			var sum atomic.Int64
+			Expect(parallel.ForEach(ctx, largeSlice, 8, func(_ context.Context, x int) error {
+				sum.Add(int64(x))
+				return nil
+			})).To(Succeed())
+			Expect(sum.Load()).To(Equal(int64(499500)))
+		})
+		It("should return the first error", func() {
+			Expect(parallel.ForEach(ctx, sliceD, 2, func(_ context.Context, x int) error {
+				if x == 3 {
+					return errors.New("failed")
+				}
+				return nil
+			})).To(MatchError("failed"))
+		})
+	})
+
+	Describe("tests for Reduce()", func() {
+		It("should combine the mapped values in order", func() {
+			f := func(_ context.Context, x int) (string, error) {
+				return string(rune('0' + x)), nil
+			}
+			combine := func(r string, s string) string {
+				return r + s
+			}
+			Expect(parallel.Reduce(ctx, sliceD, 3, ">", f, combine)).To(Equal(">9656377128"))
+			Expect(parallel.Reduce(ctx, nilSlice, 3, ">", f, combine)).To(Equal(">"))
+		})
+	})
+
+	Describe("tests for Any() and All()", func() {
+		Context("with empty slices", func() {
+			It("should return the neutral results", func() {
+				Expect(parallel.Any(ctx, nilSlice, 2, func(context.Context, int) (bool, error) { return true, nil })).To(BeFalse())
+				Expect(parallel.All(ctx, nilSlice, 2, func(context.Context, int) (bool, error) { return false, nil })).To(BeTrue())
+			})
+		})
+		Context("with a more complex slice", func() {
+			It("should evaluate the predicate", func() {
+				Expect(parallel.Any(ctx, sliceD, 2, func(_ context.Context, x int) (bool, error) { return x > 8, nil })).To(BeTrue())
+				Expect(parallel.Any(ctx, sliceD, 2, func(_ context.Context, x int) (bool, error) { return x > 9, nil })).To(BeFalse())
+				Expect(parallel.All(ctx, sliceD, 2, func(_ context.Context, x int) (bool, error) { return x > 0, nil })).To(BeTrue())
+				Expect(parallel.All(ctx, sliceD, 2, func(_ context.Context, x int) (bool, error) { return x > 1, nil })).To(BeFalse())
+			})
+		})
+		Context("with a match early in a large slice", func() {
+			It("should short-circuit", func() {
+				var calls atomic.Int64
+				f := func(ctx context.Context, x int) (bool, error) {
+					calls.Add(1)
+					time.Sleep(time.Millisecond)
+					return x == 5, nil
+				}
+				Expect(parallel.Any(ctx, largeSlice, 4, f)).To(BeTrue())
+				Expect(calls.Load()).To(BeNumerically("<", 100))
+				calls.Store(0)
+				Expect(parallel.All(ctx, largeSlice, 4, func(ctx context.Context, x int) (bool, error) {
+					ok, err := f(ctx, x)
+					return !ok, err
+				})).To(BeFalse())
+				Expect(calls.Load()).To(BeNumerically("<", 100))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				_, err := parallel.Any(ctx, sliceD, 2, func(context.Context, int) (bool, error) { return false, errors.New("failed") })
+				Expect(err).To(MatchError("failed"))
+			})
+		})
+	})
+})
@@ -0,0 +1,225 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets
+
+// Get union of sets (as a new set).
+// If no sets are given, an empty set will be returned.
+func Union[T comparable](s ...Set[T]) Set[T] {
+	i := largest(s)
+	if i < 0 {
+		return New[T]()
+	}
+	r := Clone(s[i])
+	for j, t := range s {
+		if j != i {
+			UnionInPlace(&r, t)
+		}
+	}
+	return r
+}
+
+// Add all elements of the sets t to the set s.
+func UnionInPlace[T comparable](s *Set[T], t ...Set[T]) {
+	initialize(s)
+	for _, u := range t {
+		for x := range u.m {
+			s.m[x] = struct{}{}
+		}
+	}
+}
+
+// Get intersection of sets (as a new set).
+// If no sets are given, an empty set will be returned.
+func Intersection[T comparable](s ...Set[T]) Set[T] {
+	i := smallest(s)
+	if i < 0 {
+		return New[T]()
+	}
+	r := New[T]()
+	for x := range s[i].m {
+		if containedInAll(x, s, i) {
+			r.m[x] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Remove all elements from the set s which are not contained in all of the sets t.
+// If no sets t are given, s remains unchanged.
+func IntersectionInPlace[T comparable](s Set[T], t ...Set[T]) {
+	if len(t) == 0 {
+		return
+	}
+	i := smallest(t)
+	if len(t[i].m) < len(s.m) {
+		// iterate over the smaller operand, and collect the surviving elements
+		keep := make(map[T]struct{})
+		for x := range t[i].m {
+			if _, ok := s.m[x]; ok && containedInAll(x, t, i) {
+				keep[x] = struct{}{}
+			}
+		}
+		clear(s.m)
+		for x := range keep {
+			s.m[x] = struct{}{}
+		}
+		return
+	}
+	for x := range s.m {
+		if !containedInAll(x, t, -1) {
+			delete(s.m, x)
+		}
+	}
+}
+
+// Get difference of sets (as a new set), that is all elements of s which are not contained in any of the sets t.
+func Difference[T comparable](s Set[T], t ...Set[T]) Set[T] {
+	r := New[T]()
+	if n := totalLen(t); n < len(s.m) {
+		// t is smaller than s, so start with a copy of s and remove elements of t
+		UnionInPlace(&r, s)
+		DifferenceInPlace(r, t...)
+		return r
+	}
+	for x := range s.m {
+		if !containedInAny(x, t) {
+			r.m[x] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Remove all elements from the set s which are contained in any of the sets t.
+func DifferenceInPlace[T comparable](s Set[T], t ...Set[T]) {
+	if n := totalLen(t); n < len(s.m) {
+		for _, u := range t {
+			for x := range u.m {
+				delete(s.m, x)
+			}
+		}
+		return
+	}
+	for x := range s.m {
+		if containedInAny(x, t) {
+			delete(s.m, x)
+		}
+	}
+}
+
+// Get symmetric difference of sets (as a new set), that is all elements which are contained in an odd number of the given sets.
+// In particular, for two sets, the result contains the elements which are contained in exactly one of them.
+// If no sets are given, an empty set will be returned.
+func SymmetricDifference[T comparable](s ...Set[T]) Set[T] {
+	i := largest(s)
+	if i < 0 {
+		return New[T]()
+	}
+	r := Clone(s[i])
+	for j, t := range s {
+		if j != i {
+			SymmetricDifferenceInPlace(&r, t)
+		}
+	}
+	return r
+}
+
+// Replace the set s by the symmetric difference of s and the sets t.
+func SymmetricDifferenceInPlace[T comparable](s *Set[T], t ...Set[T]) {
+	initialize(s)
+	for _, u := range t {
+		for x := range u.m {
+			if _, ok := s.m[x]; ok {
+				delete(s.m, x)
+			} else {
+				s.m[x] = struct{}{}
+			}
+		}
+	}
+}
+
+// Check if s is a subset of t, that is if all elements of s are contained in t.
+func IsSubset[T comparable](s Set[T], t Set[T]) bool {
+	if len(s.m) > len(t.m) {
+		return false
+	}
+	for x := range s.m {
+		if _, ok := t.m[x]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if s is a superset of t, that is if all elements of t are contained in s.
+func IsSuperset[T comparable](s Set[T], t Set[T]) bool {
+	return IsSubset(t, s)
+}
+
+// Check if s and t are disjoint, that is if they have no common elements.
+func IsDisjoint[T comparable](s Set[T], t Set[T]) bool {
+	if len(s.m) > len(t.m) {
+		s, t = t, s
+	}
+	for x := range s.m {
+		if _, ok := t.m[x]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Get index of the smallest set; returns -1 if s is empty.
+func smallest[T comparable](s []Set[T]) int {
+	i := -1
+	for j, t := range s {
+		if i < 0 || len(t.m) < len(s[i].m) {
+			i = j
+		}
+	}
+	return i
+}
+
+// Get index of the largest set; returns -1 if s is empty.
+func largest[T comparable](s []Set[T]) int {
+	i := -1
+	for j, t := range s {
+		if i < 0 || len(t.m) > len(s[i].m) {
+			i = j
+		}
+	}
+	return i
+}
+
+// Get total number of elements of the given sets (counting common elements multiple times).
+func totalLen[T comparable](s []Set[T]) (n int) {
+	for _, t := range s {
+		n += len(t.m)
+	}
+	return
+}
+
+// Check if x is contained in all of the given sets, skipping the set with index skip.
+func containedInAll[T comparable](x T, s []Set[T], skip int) bool {
+	for j, t := range s {
+		if j == skip {
+			continue
+		}
+		if _, ok := t.m[x]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if x is contained in any of the given sets.
+func containedInAny[T comparable](x T, s []Set[T]) bool {
+	for _, t := range s {
+		if _, ok := t.m[x]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/sets"
+)
+
+var _ = Describe("sets (algebra)", func() {
+	var emptySet sets.Set[int]
+	var setA sets.Set[int]
+	var setB sets.Set[int]
+	var setC sets.Set[int]
+
+	BeforeEach(func() {
+		emptySet = sets.New[int]()
+		setA = sets.New(1, 2, 3)
+		setB = sets.New(2, 3, 4, 5)
+		setC = sets.New(3, 5, 7)
+	})
+
+	AfterEach(func() {
+		Expect(sets.Equal(emptySet, sets.New[int]())).To(BeTrue())
+		Expect(sets.Equal(setA, sets.New(1, 2, 3))).To(BeTrue())
+		Expect(sets.Equal(setB, sets.New(2, 3, 4, 5))).To(BeTrue())
+		Expect(sets.Equal(setC, sets.New(3, 5, 7))).To(BeTrue())
+	})
+
+	Describe("tests for Union()", func() {
+		Context("with no sets", func() {
+			It("should return an empty set", func() {
+				Expect(sets.Values(sets.Union[int]())).To(BeEmpty())
+			})
+		})
+		Context("with an empty set", func() {
+			It("should return the other set", func() {
+				Expect(sets.Values(sets.Union(emptySet, setA))).To(ConsistOf(1, 2, 3))
+			})
+		})
+		Context("with several sets", func() {
+			It("should return the union", func() {
+				Expect(sets.Values(sets.Union(setA, setB, setC))).To(ConsistOf(1, 2, 3, 4, 5, 7))
+			})
+		})
+	})
+
+	Describe("tests for UnionInPlace()", func() {
+		Context("with several sets", func() {
+			It("should augment the set", func() {
+				set := sets.Clone(setA)
+				sets.UnionInPlace(&set, setB, setC)
+				Expect(sets.Values(set)).To(ConsistOf(1, 2, 3, 4, 5, 7))
+			})
+		})
+	})
+
+	Describe("tests for Intersection()", func() {
+		Context("with no sets", func() {
+			It("should return an empty set", func() {
+				Expect(sets.Values(sets.Intersection[int]())).To(BeEmpty())
+			})
+		})
+		Context("with an empty set", func() {
+			It("should return an empty set", func() {
+				Expect(sets.Values(sets.Intersection(setA, emptySet))).To(BeEmpty())
+			})
+		})
+		Context("with several sets", func() {
+			It("should return the intersection", func() {
+				Expect(sets.Values(sets.Intersection(setA, setB))).To(ConsistOf(2, 3))
+				Expect(sets.Values(sets.Intersection(setA, setB, setC))).To(ConsistOf(3))
+			})
+		})
+	})
+
+	Describe("tests for IntersectionInPlace()", func() {
+		Context("with no other sets", func() {
+			It("should leave the set unchanged", func() {
+				set := sets.Clone(setA)
+				sets.IntersectionInPlace(set)
+				Expect(sets.Values(set)).To(ConsistOf(1, 2, 3))
+			})
+		})
+		Context("with smaller other sets", func() {
+			It("should diminish the set", func() {
+				set := sets.Clone(setB)
+				sets.IntersectionInPlace(set, setC)
+				Expect(sets.Values(set)).To(ConsistOf(3, 5))
+			})
+		})
+		Context("with larger other sets", func() {
+			It("should diminish the set", func() {
+				set := sets.Clone(setA)
+				sets.IntersectionInPlace(set, setB, setC)
+				Expect(sets.Values(set)).To(ConsistOf(3))
+			})
+		})
+	})
+
+	Describe("tests for Difference()", func() {
+		Context("with no other sets", func() {
+			It("should return a copy of the set", func() {
+				Expect(sets.Values(sets.Difference(setA))).To(ConsistOf(1, 2, 3))
+			})
+		})
+		Context("with smaller other sets", func() {
+			It("should return the difference", func() {
+				Expect(sets.Values(sets.Difference(setB, sets.New(4)))).To(ConsistOf(2, 3, 5))
+			})
+		})
+		Context("with larger other sets", func() {
+			It("should return the difference", func() {
+				Expect(sets.Values(sets.Difference(setA, setB, setC))).To(ConsistOf(1))
+			})
+		})
+	})
+
+	Describe("tests for DifferenceInPlace()", func() {
+		Context("with smaller other sets", func() {
+			It("should diminish the set", func() {
+				set := sets.Clone(setB)
+				sets.DifferenceInPlace(set, sets.New(4))
+				Expect(sets.Values(set)).To(ConsistOf(2, 3, 5))
+			})
+		})
+		Context("with larger other sets", func() {
+			It("should diminish the set", func() {
+				set := sets.Clone(setA)
+				sets.DifferenceInPlace(set, setB, setC)
+				Expect(sets.Values(set)).To(ConsistOf(1))
+			})
+		})
+		Context("with the set itself", func() {
+			It("should clear the set", func() {
+				set := sets.Clone(setA)
+				sets.DifferenceInPlace(set, set)
+				Expect(sets.Values(set)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("tests for SymmetricDifference()", func() {
+		Context("with no sets", func() {
+			It("should return an empty set", func() {
+				Expect(sets.Values(sets.SymmetricDifference[int]())).To(BeEmpty())
+			})
+		})
+		Context("with two sets", func() {
+			It("should return the elements contained in exactly one of them", func() {
+				Expect(sets.Values(sets.SymmetricDifference(setA, setB))).To(ConsistOf(1, 4, 5))
+			})
+		})
+		Context("with three sets", func() {
+			It("should return the elements contained in an odd number of them", func() {
+				Expect(sets.Values(sets.SymmetricDifference(setA, setB, setC))).To(ConsistOf(1, 3, 4, 7))
+			})
+		})
+	})
+
+	Describe("tests for SymmetricDifferenceInPlace()", func() {
+		Context("with two sets", func() {
+			It("should replace the set by the symmetric difference", func() {
+				set := sets.Clone(setA)
+				sets.SymmetricDifferenceInPlace(&set, setB)
+				Expect(sets.Values(set)).To(ConsistOf(1, 4, 5))
+			})
+		})
+	})
+
+	Describe("tests for IsSubset() and IsSuperset()", func() {
+		Context("with an empty set", func() {
+			It("should be a subset of any set", func() {
+				Expect(sets.IsSubset(emptySet, setA)).To(BeTrue())
+				Expect(sets.IsSuperset(setA, emptySet)).To(BeTrue())
+				Expect(sets.IsSubset(setA, emptySet)).To(BeFalse())
+			})
+		})
+		Context("with equal sets", func() {
+			It("should return true", func() {
+				Expect(sets.IsSubset(setA, sets.New(1, 2, 3))).To(BeTrue())
+				Expect(sets.IsSuperset(setA, sets.New(1, 2, 3))).To(BeTrue())
+			})
+		})
+		Context("with a proper subset", func() {
+			It("should return true", func() {
+				Expect(sets.IsSubset(sets.New(2, 4), setB)).To(BeTrue())
+				Expect(sets.IsSuperset(setB, sets.New(2, 4))).To(BeTrue())
+			})
+		})
+		Context("with overlapping sets", func() {
+			It("should return false", func() {
+				Expect(sets.IsSubset(setA, setB)).To(BeFalse())
+				Expect(sets.IsSuperset(setA, setB)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("tests for IsDisjoint()", func() {
+		Context("with an empty set", func() {
+			It("should return true", func() {
+				Expect(sets.IsDisjoint(emptySet, setA)).To(BeTrue())
+			})
+		})
+		Context("with disjoint sets", func() {
+			It("should return true", func() {
+				Expect(sets.IsDisjoint(setA, sets.New(4, 5, 6, 7))).To(BeTrue())
+			})
+		})
+		Context("with overlapping sets", func() {
+			It("should return false", func() {
+				Expect(sets.IsDisjoint(setA, setC)).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,102 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets
+
+import (
+	"iter"
+	"sync"
+)
+
+// Set, safe for concurrent use.
+// The zero value is an empty set, ready to use. Concurrent sets must not be copied after first use.
+type Concurrent[T comparable] struct {
+	mu sync.RWMutex
+	s  Set[T]
+}
+
+// Create new concurrent set.
+func NewConcurrent[T comparable](x ...T) *Concurrent[T] {
+	return &Concurrent[T]{s: New(x...)}
+}
+
+// Get number of elements in the set.
+func (c *Concurrent[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Len(c.s)
+}
+
+// Check if set contains specified element.
+func (c *Concurrent[T]) Contains(x T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Contains(c.s, x)
+}
+
+// Add specified element to set; reports whether the element was added (i.e. was not contained before).
+func (c *Concurrent[T]) Add(x T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if Contains(c.s, x) {
+		return false
+	}
+	Add(&c.s, x)
+	return true
+}
+
+// Delete specified element from set; reports whether the element was deleted (i.e. was contained before).
+func (c *Concurrent[T]) Delete(x T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !Contains(c.s, x) {
+		return false
+	}
+	Delete(c.s, x)
+	return true
+}
+
+// Atomically update the set by given function; the function receives the underlying set, which must not be retained.
+func (c *Concurrent[T]) Update(f func(s *Set[T])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f(&c.s)
+}
+
+// Atomically replace the set by the given set (which is copied), and return the previous content.
+func (c *Concurrent[T]) Swap(s Set[T]) Set[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	previous := c.s
+	c.s = Clone(s)
+	return previous
+}
+
+// Remove all elements.
+func (c *Concurrent[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s = Set[T]{}
+}
+
+// Get snapshot of the set as ordinary set.
+func (c *Concurrent[T]) Snapshot() Set[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Clone(c.s)
+}
+
+// Get snapshot of the values of the set as slice; order is not predictable.
+// Will return an empty non-nil slice in case the set is empty.
+func (c *Concurrent[T]) Values() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Values(c.s)
+}
+
+// Get all elements of a snapshot of the set as sequence; order is not predictable.
+func (c *Concurrent[T]) All() iter.Seq[T] {
+	return All(c.Snapshot())
+}
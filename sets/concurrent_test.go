@@ -0,0 +1,78 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets_test
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/sets"
+)
+
+var _ = Describe("sets (concurrent set)", func() {
+	Describe("tests for a zero value concurrent set", func() {
+		It("should behave like an empty set", func() {
+			var c sets.Concurrent[int]
+			Expect(c.Len()).To(Equal(0))
+			Expect(c.Contains(1)).To(BeFalse())
+			Expect(c.Delete(1)).To(BeFalse())
+			Expect(c.Values()).To(Equal([]int{}))
+			Expect(c.Add(1)).To(BeTrue())
+			Expect(c.Add(1)).To(BeFalse())
+			Expect(c.Values()).To(ConsistOf(1))
+		})
+	})
+
+	Describe("tests for snapshots", func() {
+		It("should be decoupled from the concurrent set", func() {
+			c := sets.NewConcurrent(1, 2)
+			s := c.Snapshot()
+			c.Add(3)
+			Expect(sets.Values(s)).To(ConsistOf(1, 2))
+			previous := c.Swap(s)
+			Expect(sets.Values(previous)).To(ConsistOf(1, 2, 3))
+			sets.Add(&s, 4)
+			Expect(c.Values()).To(ConsistOf(1, 2))
+			var r []int
+			for x := range c.All() {
+				r = append(r, x)
+			}
+			Expect(r).To(ConsistOf(1, 2))
+			c.Clear()
+			Expect(c.Len()).To(Equal(0))
+		})
+	})
+
+	Describe("tests for concurrent use", func() {
+		It("should add each element exactly once", func() {
+			c := sets.NewConcurrent[int]()
+			var mu sync.Mutex
+			added := 0
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 100; j++ {
+						if c.Add(j) {
+							mu.Lock()
+							added++
+							mu.Unlock()
+						}
+						c.Contains(j)
+						c.Update(func(s *sets.Set[int]) {
+							sets.Add(s, -1)
+						})
+					}
+				}()
+			}
+			wg.Wait()
+			Expect(added).To(Equal(100))
+			Expect(c.Len()).To(Equal(101))
+		})
+	})
+})
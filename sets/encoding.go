@@ -0,0 +1,187 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/sap/go-generics/internal/reflection"
+)
+
+// Separator used by the text encoding of sets.
+const textSeparator = ","
+
+// Set with strict unmarshalling.
+// Behaves exactly like Set (and can be converted from and to Set), but unmarshalling rejects duplicate entries.
+// Intended to be used as type of struct fields, e.g. in API types.
+type StrictSet[T comparable] Set[T]
+
+// Marshal set to JSON; implements json.Marshaler.
+// The set is encoded as array; if T is orderable (in the sense of the slices.Orderable constraint), the array is sorted.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sortedValues(s))
+}
+
+// Unmarshal set from JSON; implements json.Unmarshaler.
+// Accepts an array (possibly containing duplicates) or null; existing elements of the set are discarded.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(s, data, false)
+}
+
+// Marshal set to JSON; implements json.Marshaler.
+// See Set.MarshalJSON() for details.
+func (s StrictSet[T]) MarshalJSON() ([]byte, error) {
+	return Set[T](s).MarshalJSON()
+}
+
+// Unmarshal set from JSON; implements json.Unmarshaler.
+// Accepts an array or null; returns an error if the array contains duplicates.
+func (s *StrictSet[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*Set[T])(s), data, true)
+}
+
+// Marshal set to text; implements encoding.TextMarshaler.
+// The set is encoded as comma-separated list of its elements (sorted, if T is orderable).
+// Supported element types are strings, booleans, numbers, and types implementing encoding.TextMarshaler.
+// An error is returned for other element types, or if the text representation of an element contains a comma.
+// Since the empty text denotes the empty set, an error is also returned if the set consists of a single element
+// with empty text representation (such as the empty string); the empty string may occur along with other elements.
+func (s Set[T]) MarshalText() ([]byte, error) {
+	values := sortedValues(s)
+	texts := make([]string, len(values))
+	for i, x := range values {
+		text, err := marshalElementText(x)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(text, textSeparator) {
+			return nil, fmt.Errorf("cannot marshal set element %q to text: contains separator %q", text, textSeparator)
+		}
+		texts[i] = text
+	}
+	if len(texts) == 1 && texts[0] == "" {
+		return nil, errors.New("cannot marshal set consisting of a single element with empty text to text")
+	}
+	return []byte(strings.Join(texts, textSeparator)), nil
+}
+
+// Unmarshal set from text; implements encoding.TextUnmarshaler.
+// Accepts a comma-separated list of elements (possibly containing duplicates); existing elements of the set are discarded.
+func (s *Set[T]) UnmarshalText(text []byte) error {
+	return unmarshalText(s, text, false)
+}
+
+// Marshal set to text; implements encoding.TextMarshaler.
+// See Set.MarshalText() for details.
+func (s StrictSet[T]) MarshalText() ([]byte, error) {
+	return Set[T](s).MarshalText()
+}
+
+// Unmarshal set from text; implements encoding.TextUnmarshaler.
+// Accepts a comma-separated list of elements; returns an error if the list contains duplicates.
+func (s *StrictSet[T]) UnmarshalText(text []byte) error {
+	return unmarshalText((*Set[T])(s), text, true)
+}
+
+// Encode set by gob; implements gob.GobEncoder.
+// The set is encoded as slice of its elements (sorted, if T is orderable).
+func (s Set[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(sortedValues(s)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode set by gob; implements gob.GobDecoder.
+// Existing elements of the set are discarded.
+func (s *Set[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	return fill(s, values, false)
+}
+
+// Encode set by gob; implements gob.GobEncoder.
+func (s StrictSet[T]) GobEncode() ([]byte, error) {
+	return Set[T](s).GobEncode()
+}
+
+// Decode set by gob; implements gob.GobDecoder.
+// Returns an error if the encoded data contains duplicates.
+func (s *StrictSet[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	return fill((*Set[T])(s), values, true)
+}
+
+func unmarshalJSON[T comparable](s *Set[T], data []byte, strict bool) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	return fill(s, values, strict)
+}
+
+func unmarshalText[T comparable](s *Set[T], text []byte, strict bool) error {
+	var values []T
+	if len(text) > 0 {
+		for _, t := range strings.Split(string(text), textSeparator) {
+			x, err := unmarshalElementText[T](t)
+			if err != nil {
+				return err
+			}
+			values = append(values, x)
+		}
+	}
+	return fill(s, values, strict)
+}
+
+// Replace the elements of the set by the given values; if strict is true, duplicate values are rejected.
+// In case of error, the set remains unchanged.
+func fill[T comparable](s *Set[T], values []T, strict bool) error {
+	m := make(map[T]struct{}, len(values))
+	for _, x := range values {
+		if _, ok := m[x]; ok && strict {
+			return fmt.Errorf("duplicate set element: %v", x)
+		}
+		m[x] = struct{}{}
+	}
+	s.m = m
+	return nil
+}
+
+// Get values of set as slice; the slice is sorted if T is orderable (in the sense of the slices.Orderable constraint).
+func sortedValues[T comparable](s Set[T]) []T {
+	values := Values(s)
+	reflection.Sort(values)
+	return values
+}
+
+func marshalElementText[T comparable](x T) (string, error) {
+	text, ok, err := reflection.MarshalText(x, reflection.ValueRules)
+	if !ok {
+		return "", fmt.Errorf("cannot marshal set element of type %s to text", reflect.TypeFor[T]())
+	}
+	return text, err
+}
+
+func unmarshalElementText[T comparable](text string) (T, error) {
+	x, ok, err := reflection.UnmarshalText[T](text, reflection.ValueRules)
+	if !ok {
+		return x, fmt.Errorf("cannot unmarshal set element of type %s from text", reflect.TypeFor[T]())
+	}
+	return x, err
+}
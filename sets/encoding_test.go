@@ -0,0 +1,176 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/sets"
+)
+
+type point struct {
+	X int
+	Y int
+}
+
+var _ = Describe("sets (encoding)", func() {
+	Describe("tests for JSON marshalling", func() {
+		Context("with a zero value set", func() {
+			It("should return an empty array", func() {
+				Expect(json.Marshal(sets.Set[int]{})).To(MatchJSON(`[]`))
+			})
+		})
+		Context("with orderable elements", func() {
+			It("should return a sorted array", func() {
+				Expect(json.Marshal(sets.New(3, 1, 2))).To(Equal([]byte(`[1,2,3]`)))
+				Expect(json.Marshal(sets.New("b", "c", "a"))).To(Equal([]byte(`["a","b","c"]`)))
+			})
+		})
+		Context("with non-orderable elements", func() {
+			It("should return an array of the elements", func() {
+				data, err := json.Marshal(sets.New(point{1, 2}, point{3, 4}))
+				Expect(err).NotTo(HaveOccurred())
+				var values []point
+				Expect(json.Unmarshal(data, &values)).To(Succeed())
+				Expect(values).To(ConsistOf(point{1, 2}, point{3, 4}))
+			})
+		})
+		Context("with a set embedded in a struct", func() {
+			It("should roundtrip", func() {
+				type object struct {
+					Names sets.Set[string] `json:"names"`
+				}
+				data, err := json.Marshal(object{Names: sets.New("x", "y")})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(data).To(MatchJSON(`{"names":["x","y"]}`))
+				var o object
+				Expect(json.Unmarshal(data, &o)).To(Succeed())
+				Expect(sets.Values(o.Names)).To(ConsistOf("x", "y"))
+			})
+		})
+	})
+
+	Describe("tests for JSON unmarshalling", func() {
+		Context("with null", func() {
+			It("should return an empty set", func() {
+				set := sets.New(1)
+				Expect(json.Unmarshal([]byte(`null`), &set)).To(Succeed())
+				Expect(sets.Len(set)).To(Equal(0))
+			})
+		})
+		Context("with duplicates", func() {
+			It("should succeed in non-strict mode", func() {
+				var set sets.Set[int]
+				Expect(json.Unmarshal([]byte(`[1,2,2]`), &set)).To(Succeed())
+				Expect(sets.Values(set)).To(ConsistOf(1, 2))
+			})
+			It("should fail in strict mode", func() {
+				var set sets.StrictSet[int]
+				Expect(json.Unmarshal([]byte(`[1,2,2]`), &set)).To(MatchError(ContainSubstring("duplicate")))
+				Expect(json.Unmarshal([]byte(`[1,2]`), &set)).To(Succeed())
+				Expect(sets.Values(sets.Set[int](set))).To(ConsistOf(1, 2))
+			})
+		})
+		Context("with invalid input", func() {
+			It("should fail", func() {
+				var set sets.Set[int]
+				Expect(json.Unmarshal([]byte(`{}`), &set)).NotTo(Succeed())
+				Expect(json.Unmarshal([]byte(`["a"]`), &set)).NotTo(Succeed())
+			})
+		})
+	})
+
+	Describe("tests for text marshalling", func() {
+		Context("with an empty set", func() {
+			It("should return an empty text", func() {
+				Expect(sets.New[string]().MarshalText()).To(BeEmpty())
+			})
+		})
+		Context("with supported elements", func() {
+			It("should return a sorted comma-separated list", func() {
+				Expect(sets.New(3, 1, 2).MarshalText()).To(Equal([]byte("1,2,3")))
+				Expect(sets.New(2.5, 1.0).MarshalText()).To(Equal([]byte("1,2.5")))
+			})
+		})
+		Context("with elements containing the separator", func() {
+			It("should fail", func() {
+				_, err := sets.New("a,b").MarshalText()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("with the empty string as only element", func() {
+			It("should fail, since the result could not be told apart from the empty set", func() {
+				_, err := sets.New("").MarshalText()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("with the empty string along with other elements", func() {
+			It("should roundtrip", func() {
+				text, err := sets.New("", "a").MarshalText()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(text).To(Equal([]byte(",a")))
+				var set sets.Set[string]
+				Expect(set.UnmarshalText(text)).To(Succeed())
+				Expect(sets.Values(set)).To(ConsistOf("", "a"))
+			})
+		})
+		Context("with unsupported elements", func() {
+			It("should fail", func() {
+				_, err := sets.New(point{1, 2}).MarshalText()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("tests for text unmarshalling", func() {
+		Context("with an empty text", func() {
+			It("should return an empty set", func() {
+				set := sets.New(1)
+				Expect(set.UnmarshalText(nil)).To(Succeed())
+				Expect(sets.Len(set)).To(Equal(0))
+			})
+		})
+		Context("with a comma-separated list", func() {
+			It("should return the set of parsed elements", func() {
+				var set sets.Set[uint8]
+				Expect(set.UnmarshalText([]byte("1,2,2"))).To(Succeed())
+				Expect(sets.Values(set)).To(ConsistOf(uint8(1), uint8(2)))
+			})
+		})
+		Context("with duplicates in strict mode", func() {
+			It("should fail", func() {
+				var set sets.StrictSet[string]
+				Expect(set.UnmarshalText([]byte("a,b,a"))).NotTo(Succeed())
+			})
+		})
+		Context("with invalid elements", func() {
+			It("should fail and leave the set unchanged", func() {
+				set := sets.New[uint8](7)
+				Expect(set.UnmarshalText([]byte("1,256"))).NotTo(Succeed())
+				Expect(sets.Values(set)).To(ConsistOf(uint8(7)))
+			})
+		})
+	})
+
+	Describe("tests for gob encoding", func() {
+		Context("with a set embedded in a struct", func() {
+			It("should roundtrip", func() {
+				type object struct {
+					Points sets.Set[point]
+				}
+				var buf bytes.Buffer
+				Expect(gob.NewEncoder(&buf).Encode(object{Points: sets.New(point{1, 2}, point{3, 4})})).To(Succeed())
+				var o object
+				Expect(gob.NewDecoder(&buf).Decode(&o)).To(Succeed())
+				Expect(sets.Values(o.Points)).To(ConsistOf(point{1, 2}, point{3, 4}))
+			})
+		})
+	})
+})
@@ -0,0 +1,28 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets
+
+import (
+	"iter"
+
+	"github.com/sap/go-generics/maps"
+)
+
+// Get all elements of set as sequence; order is not predictable.
+// The returned sequence will not yield any elements in case the set is empty.
+func All[T comparable](s Set[T]) iter.Seq[T] {
+	return maps.KeysSeq(s.m)
+}
+
+// Build set from sequence.
+// Duplicate elements yielded by the sequence will be added only once.
+func FromSeq[T comparable](seq iter.Seq[T]) Set[T] {
+	s := New[T]()
+	for x := range seq {
+		s.m[x] = struct{}{}
+	}
+	return s
+}
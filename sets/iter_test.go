@@ -0,0 +1,43 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/sets"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("sets (iterators)", func() {
+	Describe("tests for All()", func() {
+		Context("with an empty set", func() {
+			It("should yield nothing", func() {
+				for range sets.All(sets.New[int]()) {
+					Fail("unexpected element")
+				}
+			})
+		})
+		Context("with a non-empty set", func() {
+			It("should yield the values", func() {
+				var r []int
+				for x := range sets.All(sets.New(1, 2, 3, 3)) {
+					r = append(r, x)
+				}
+				Expect(r).To(ConsistOf(1, 2, 3))
+			})
+		})
+	})
+
+	Describe("tests for FromSeq()", func() {
+		Context("with a sequence containing duplicates", func() {
+			It("should return the set of yielded values", func() {
+				s := sets.FromSeq(slices.Seq([]int{1, 2, 3, 2, 1}))
+				Expect(sets.Equal(s, sets.New(1, 2, 3))).To(BeTrue())
+			})
+		})
+	})
+})
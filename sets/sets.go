@@ -8,7 +8,11 @@ package sets
 import "github.com/sap/go-generics/maps"
 
 // Set.
-// Always create sets with the New() function, do not use unininizialized sets (i.e. sets having the zero value).
+// The zero value is an empty set, ready to use; the underlying map will be allocated on first write.
+// Functions which may add elements to a set (Add(), UnionInPlace() and SymmetricDifferenceInPlace()) therefore
+// take a pointer to it, so that this allocation is visible to the caller; functions which only remove elements
+// (Delete(), IntersectionInPlace() and DifferenceInPlace()) take the set by value.
+// Note that sets have reference semantics once initialized: copies of a non-zero set share their elements.
 type Set[T comparable] struct {
 	m map[T]struct{}
 }
@@ -39,6 +43,9 @@ func Len[T comparable](s Set[T]) int {
 // Get values of set as slice; order is not predictable.
 // Will return an empty non-nil slice in case the set is empty.
 func Values[T comparable](s Set[T]) []T {
+	if s.m == nil {
+		return make([]T, 0)
+	}
 	return maps.Keys(s.m)
 }
 
@@ -49,7 +56,8 @@ func Contains[T comparable](s Set[T], x T) bool {
 }
 
 // Add specified element to set.
-func Add[T comparable](s Set[T], x T) {
+func Add[T comparable](s *Set[T], x T) {
+	initialize(s)
 	s.m[x] = struct{}{}
 }
 
@@ -71,3 +79,10 @@ func Equal[T comparable](s Set[T], t Set[T]) bool {
 	}
 	return true
 }
+
+// Allocate the underlying map of the set, if not yet done.
+func initialize[T comparable](s *Set[T]) {
+	if s.m == nil {
+		s.m = make(map[T]struct{})
+	}
+}
@@ -80,14 +80,14 @@ var _ = Describe("sets", func() {
 		Context("with a set that contains the value", func() {
 			It("should return the set unchanged", func() {
 				set := sets.Clone(setA)
-				sets.Add(set, 3)
+				sets.Add(&set, 3)
 				Expect(sets.Equal(set, sets.New(1, 2, 3))).To(BeTrue())
 			})
 		})
 		Context("with a set that does not contain the value", func() {
 			It("should return the augmented set", func() {
 				set := sets.Clone(setA)
-				sets.Add(set, 4)
+				sets.Add(&set, 4)
 				Expect(sets.Equal(set, sets.New(1, 2, 3, 4))).To(BeTrue())
 			})
 		})
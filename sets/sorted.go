@@ -0,0 +1,361 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets
+
+import (
+	"iter"
+
+	"github.com/sap/go-generics/slices"
+	"github.com/sap/go-generics/treemap"
+)
+
+// Sorted set.
+// Elements are kept ordered, either by their natural order, or by a comparator function; two elements are considered
+// equal if neither is larger than the other. Operations combining several sorted sets use the ordering of the receiver,
+// that is, elements of the other sets are looked up by means of the receiver's comparator (even if the other sets
+// are ordered differently).
+// Always create sorted sets with the NewSorted() or NewSortedBy() functions, do not use uninitialized sorted sets
+// (i.e. sorted sets having the zero value).
+type SortedSet[T any] struct {
+	t *treemap.TreeMap[T, struct{}]
+	o *order[T]
+}
+
+// Ordering of a sorted set; shared by all sets derived from each other (and by all sets created by NewSorted()),
+// so that combining operations can skip re-ordering other sets which are known to be ordered like the receiver.
+type order[T any] struct {
+	f       func(x, y T) bool
+	natural bool
+}
+
+// Create new sorted set of orderable elements.
+func NewSorted[T slices.Orderable](x ...T) *SortedSet[T] {
+	f := func(x, y T) bool {
+		return x > y
+	}
+	return newSorted(&order[T]{f: f, natural: true}, x...)
+}
+
+// Create new sorted set, ordering elements by given comparator function.
+// As for slices.SortBy(), the comparator function f(x,y) must return true if x is larger than y, and false otherwise.
+func NewSortedBy[T any](f func(x, y T) bool, x ...T) *SortedSet[T] {
+	return newSorted(&order[T]{f: f}, x...)
+}
+
+func newSorted[T any](o *order[T], x ...T) *SortedSet[T] {
+	s := &SortedSet[T]{t: treemap.NewBy[T, struct{}](o.f), o: o}
+	for _, y := range x {
+		s.Add(y)
+	}
+	return s
+}
+
+// Convert set of orderable elements into sorted set.
+func ToSorted[T slices.Orderable](s Set[T]) *SortedSet[T] {
+	return NewSorted(Values(s)...)
+}
+
+// Convert set into sorted set, ordering elements by given comparator function.
+func ToSortedBy[T comparable](s Set[T], f func(x, y T) bool) *SortedSet[T] {
+	return NewSortedBy(f, Values(s)...)
+}
+
+// Convert sorted set into (unordered) set.
+func FromSorted[T comparable](s *SortedSet[T]) Set[T] {
+	return New(s.Values()...)
+}
+
+// Clone sorted set.
+func (s *SortedSet[T]) Clone() *SortedSet[T] {
+	return s.empty().addAll(s)
+}
+
+// Get number of elements in the sorted set.
+func (s *SortedSet[T]) Len() int {
+	return s.t.Len()
+}
+
+// Get values of sorted set as slice, in ascending order.
+// Will return an empty non-nil slice in case the set is empty.
+func (s *SortedSet[T]) Values() []T {
+	return s.t.Keys()
+}
+
+// Check if sorted set contains specified element.
+func (s *SortedSet[T]) Contains(x T) bool {
+	return s.t.Has(x)
+}
+
+// Add specified element to sorted set.
+func (s *SortedSet[T]) Add(x T) {
+	s.t.Set(x, struct{}{})
+}
+
+// Delete specified element from sorted set; reports whether the element was contained in the set.
+func (s *SortedSet[T]) Delete(x T) bool {
+	return s.t.Delete(x)
+}
+
+// Get smallest element; the second return value reports whether the set is non-empty.
+func (s *SortedSet[T]) Min() (T, bool) {
+	x, _, ok := s.t.Min()
+	return x, ok
+}
+
+// Get largest element; the second return value reports whether the set is non-empty.
+func (s *SortedSet[T]) Max() (T, bool) {
+	x, _, ok := s.t.Max()
+	return x, ok
+}
+
+// Get largest element less than or equal to x; the second return value reports whether such an element exists.
+func (s *SortedSet[T]) Floor(x T) (T, bool) {
+	y, _, ok := s.t.Floor(x)
+	return y, ok
+}
+
+// Get largest element strictly less than x; the second return value reports whether such an element exists.
+func (s *SortedSet[T]) Lower(x T) (T, bool) {
+	y, _, ok := s.t.Lower(x)
+	return y, ok
+}
+
+// Get smallest element greater than or equal to x; the second return value reports whether such an element exists.
+func (s *SortedSet[T]) Ceiling(x T) (T, bool) {
+	y, _, ok := s.t.Ceiling(x)
+	return y, ok
+}
+
+// Get smallest element strictly greater than x; the second return value reports whether such an element exists.
+func (s *SortedSet[T]) Higher(x T) (T, bool) {
+	y, _, ok := s.t.Higher(x)
+	return y, ok
+}
+
+// Get number of elements strictly less than x.
+func (s *SortedSet[T]) Rank(x T) int {
+	return s.t.Rank(x)
+}
+
+// Get element at given (zero-based) position; the second return value reports whether i is within range.
+func (s *SortedSet[T]) Select(i int) (T, bool) {
+	x, _, ok := s.t.Select(i)
+	return x, ok
+}
+
+// Get all elements as sequence, in ascending order.
+// The set must not be modified while iterating.
+func (s *SortedSet[T]) All() iter.Seq[T] {
+	return keys(s.t.All())
+}
+
+// Get all elements as sequence, in descending order.
+// The set must not be modified while iterating.
+func (s *SortedSet[T]) Backward() iter.Seq[T] {
+	return keys(s.t.Backward())
+}
+
+// Get elements in the half-open interval [from, to) as sequence, in ascending order.
+// The set must not be modified while iterating.
+func (s *SortedSet[T]) Range(from T, to T) iter.Seq[T] {
+	return keys(s.t.Range(from, to))
+}
+
+// Compare with other sorted set (by means of the ordering of the receiver).
+func (s *SortedSet[T]) Equal(t *SortedSet[T]) bool {
+	u := s.view(t)
+	return s.Len() == u.Len() && s.IsSubset(u)
+}
+
+// Get union with other sorted sets (as a new sorted set).
+func (s *SortedSet[T]) Union(t ...*SortedSet[T]) *SortedSet[T] {
+	r := s.Clone()
+	r.UnionInPlace(t...)
+	return r
+}
+
+// Add all elements of the other sorted sets.
+func (s *SortedSet[T]) UnionInPlace(t ...*SortedSet[T]) {
+	for _, u := range t {
+		s.addAll(u)
+	}
+}
+
+// Get intersection with other sorted sets (as a new sorted set).
+func (s *SortedSet[T]) Intersection(t ...*SortedSet[T]) *SortedSet[T] {
+	t = s.views(t)
+	r := s.empty()
+	for x := range s.All() {
+		if containedInAllSorted(x, t) {
+			r.Add(x)
+		}
+	}
+	return r
+}
+
+// Remove all elements which are not contained in all of the other sorted sets.
+func (s *SortedSet[T]) IntersectionInPlace(t ...*SortedSet[T]) {
+	t = s.views(t)
+	for _, x := range s.Values() {
+		if !containedInAllSorted(x, t) {
+			s.Delete(x)
+		}
+	}
+}
+
+// Get difference with other sorted sets (as a new sorted set), that is all elements which are not contained
+// in any of the other sorted sets.
+func (s *SortedSet[T]) Difference(t ...*SortedSet[T]) *SortedSet[T] {
+	t = s.views(t)
+	r := s.empty()
+	for x := range s.All() {
+		if !containedInAnySorted(x, t) {
+			r.Add(x)
+		}
+	}
+	return r
+}
+
+// Remove all elements which are contained in any of the other sorted sets.
+func (s *SortedSet[T]) DifferenceInPlace(t ...*SortedSet[T]) {
+	for _, u := range s.views(t) {
+		if u.Len() < s.Len() {
+			for _, x := range u.Values() {
+				s.Delete(x)
+			}
+			continue
+		}
+		for _, x := range s.Values() {
+			if u.Contains(x) {
+				s.Delete(x)
+			}
+		}
+	}
+}
+
+// Get symmetric difference with other sorted sets (as a new sorted set), that is all elements which are
+// contained in an odd number of the sets.
+func (s *SortedSet[T]) SymmetricDifference(t ...*SortedSet[T]) *SortedSet[T] {
+	r := s.Clone()
+	r.SymmetricDifferenceInPlace(t...)
+	return r
+}
+
+// Replace the sorted set by its symmetric difference with the other sorted sets.
+func (s *SortedSet[T]) SymmetricDifferenceInPlace(t ...*SortedSet[T]) {
+	for _, u := range t {
+		for _, x := range u.Values() {
+			if !s.Delete(x) {
+				s.Add(x)
+			}
+		}
+	}
+}
+
+// Check if the sorted set is a subset of t, that is if all of its elements are contained in t.
+func (s *SortedSet[T]) IsSubset(t *SortedSet[T]) bool {
+	t = s.view(t)
+	if s.Len() > t.Len() {
+		return false
+	}
+	for x := range s.All() {
+		if !t.Contains(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if the sorted set is a superset of t, that is if all elements of t are contained in it.
+func (s *SortedSet[T]) IsSuperset(t *SortedSet[T]) bool {
+	return s.view(t).IsSubset(s)
+}
+
+// Check if the sorted set and t are disjoint, that is if they have no common elements.
+func (s *SortedSet[T]) IsDisjoint(t *SortedSet[T]) bool {
+	u, v := s, s.view(t)
+	if u.Len() > v.Len() {
+		u, v = v, u
+	}
+	for x := range u.All() {
+		if v.Contains(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// Create empty sorted set with the same ordering.
+func (s *SortedSet[T]) empty() *SortedSet[T] {
+	return newSorted(s.o)
+}
+
+// Get t as a sorted set with the same ordering as the receiver; this is t itself if it is already ordered that way,
+// otherwise a copy (elements of t which are equal by means of the receiver's ordering are collapsed in the copy).
+func (s *SortedSet[T]) view(t *SortedSet[T]) *SortedSet[T] {
+	if s.o == t.o || s.o.natural && t.o.natural {
+		return t
+	}
+	return s.empty().addAll(t)
+}
+
+// Apply view() to all elements of t; t is returned unchanged if all its elements are already ordered like the receiver.
+func (s *SortedSet[T]) views(t []*SortedSet[T]) []*SortedSet[T] {
+	var r []*SortedSet[T]
+	for i, u := range t {
+		v := s.view(u)
+		if v != u && r == nil {
+			r = make([]*SortedSet[T], len(t))
+			copy(r, t[:i])
+		}
+		if r != nil {
+			r[i] = v
+		}
+	}
+	if r == nil {
+		return t
+	}
+	return r
+}
+
+// Add all elements of t; returns the receiver.
+func (s *SortedSet[T]) addAll(t *SortedSet[T]) *SortedSet[T] {
+	if s == t {
+		return s
+	}
+	for x := range t.All() {
+		s.Add(x)
+	}
+	return s
+}
+
+func containedInAllSorted[T any](x T, s []*SortedSet[T]) bool {
+	for _, t := range s {
+		if !t.Contains(x) {
+			return false
+		}
+	}
+	return true
+}
+
+func containedInAnySorted[T any](x T, s []*SortedSet[T]) bool {
+	for _, t := range s {
+		if t.Contains(x) {
+			return true
+		}
+	}
+	return false
+}
+
+func keys[K any, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
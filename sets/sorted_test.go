@@ -0,0 +1,151 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/sets"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("sets (sorted set)", func() {
+	var emptySet *sets.SortedSet[int]
+	var setA *sets.SortedSet[int]
+	var setB *sets.SortedSet[int]
+	var setC *sets.SortedSet[int]
+
+	BeforeEach(func() {
+		emptySet = sets.NewSorted[int]()
+		setA = sets.NewSorted(3, 1, 2, 3)
+		setB = sets.NewSorted(5, 4, 3, 2)
+		setC = sets.NewSorted(7, 5, 3)
+	})
+
+	AfterEach(func() {
+		Expect(emptySet.Values()).To(Equal([]int{}))
+		Expect(setA.Values()).To(Equal([]int{1, 2, 3}))
+		Expect(setB.Values()).To(Equal([]int{2, 3, 4, 5}))
+		Expect(setC.Values()).To(Equal([]int{3, 5, 7}))
+	})
+
+	Describe("tests for basic operations", func() {
+		It("should maintain the ordered elements", func() {
+			set := setA.Clone()
+			set.Add(0)
+			set.Add(2)
+			Expect(set.Len()).To(Equal(4))
+			Expect(set.Contains(0)).To(BeTrue())
+			Expect(set.Delete(2)).To(BeTrue())
+			Expect(set.Delete(2)).To(BeFalse())
+			Expect(set.Values()).To(Equal([]int{0, 1, 3}))
+		})
+	})
+
+	Describe("tests for NewSortedBy()", func() {
+		It("should order elements by the comparator function", func() {
+			set := sets.NewSortedBy(func(x, y string) bool { return strings.ToLower(x) > strings.ToLower(y) }, "b", "A", "a", "C")
+			Expect(set.Values()).To(Equal([]string{"A", "b", "C"}))
+			Expect(set.Contains("B")).To(BeTrue())
+		})
+	})
+
+	Describe("tests for nearest-neighbour lookup", func() {
+		It("should return the nearest elements", func() {
+			x, ok := setC.Floor(6)
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal(5))
+			x, _ = setC.Ceiling(6)
+			Expect(x).To(Equal(7))
+			x, _ = setC.Lower(5)
+			Expect(x).To(Equal(3))
+			x, _ = setC.Higher(5)
+			Expect(x).To(Equal(7))
+			_, ok = setC.Higher(7)
+			Expect(ok).To(BeFalse())
+			x, _ = setC.Min()
+			Expect(x).To(Equal(3))
+			x, _ = setC.Max()
+			Expect(x).To(Equal(7))
+			_, ok = emptySet.Min()
+			Expect(ok).To(BeFalse())
+			Expect(setC.Rank(6)).To(Equal(2))
+			x, _ = setC.Select(2)
+			Expect(x).To(Equal(7))
+		})
+	})
+
+	Describe("tests for All(), Backward() and Range()", func() {
+		It("should yield the elements in order", func() {
+			Expect(slices.FromSeq(setB.All())).To(Equal([]int{2, 3, 4, 5}))
+			Expect(slices.FromSeq(setB.Backward())).To(Equal([]int{5, 4, 3, 2}))
+			Expect(slices.FromSeq(setB.Range(3, 5))).To(Equal([]int{3, 4}))
+		})
+	})
+
+	Describe("tests for the algebra methods", func() {
+		It("should return the same results as for sets", func() {
+			Expect(setA.Union(setB, setC).Values()).To(Equal([]int{1, 2, 3, 4, 5, 7}))
+			Expect(setA.Intersection(setB).Values()).To(Equal([]int{2, 3}))
+			Expect(setA.Intersection(setB, setC).Values()).To(Equal([]int{3}))
+			Expect(setB.Difference(setA).Values()).To(Equal([]int{4, 5}))
+			Expect(setA.SymmetricDifference(setB).Values()).To(Equal([]int{1, 4, 5}))
+			Expect(setA.SymmetricDifference(setB, setC).Values()).To(Equal([]int{1, 3, 4, 7}))
+			Expect(sets.NewSorted(2, 4).IsSubset(setB)).To(BeTrue())
+			Expect(setB.IsSuperset(sets.NewSorted(2, 4))).To(BeTrue())
+			Expect(setA.IsSubset(setB)).To(BeFalse())
+			Expect(emptySet.IsSubset(setA)).To(BeTrue())
+			Expect(setA.IsDisjoint(sets.NewSorted(4, 5, 6, 7))).To(BeTrue())
+			Expect(setA.IsDisjoint(setC)).To(BeFalse())
+			Expect(setA.Equal(sets.NewSorted(1, 2, 3))).To(BeTrue())
+			Expect(setA.Equal(setB)).To(BeFalse())
+		})
+		It("should modify the set in place", func() {
+			set := setA.Clone()
+			set.UnionInPlace(setC)
+			Expect(set.Values()).To(Equal([]int{1, 2, 3, 5, 7}))
+			set.IntersectionInPlace(setB)
+			Expect(set.Values()).To(Equal([]int{2, 3, 5}))
+			set.DifferenceInPlace(setC)
+			Expect(set.Values()).To(Equal([]int{2}))
+			set.SymmetricDifferenceInPlace(setA)
+			Expect(set.Values()).To(Equal([]int{1, 3}))
+			set.DifferenceInPlace(set)
+			Expect(set.Values()).To(BeEmpty())
+		})
+		It("should use the ordering of the receiver for sets ordered differently", func() {
+			ci := sets.NewSortedBy(func(x, y string) bool { return strings.ToLower(x) > strings.ToLower(y) }, "a", "B")
+			cs := sets.NewSorted("A", "b")
+			Expect(ci.Equal(cs)).To(BeTrue())
+			Expect(cs.Equal(ci)).To(BeFalse())
+			Expect(ci.Equal(sets.NewSorted("A", "a", "b"))).To(BeTrue())
+			Expect(ci.IsSubset(cs)).To(BeTrue())
+			Expect(cs.IsSubset(ci)).To(BeFalse())
+			Expect(ci.IsSuperset(sets.NewSorted("A", "a"))).To(BeTrue())
+			Expect(cs.IsSuperset(ci)).To(BeFalse())
+			Expect(ci.IsDisjoint(cs)).To(BeFalse())
+			Expect(cs.IsDisjoint(ci)).To(BeTrue())
+			Expect(ci.Intersection(cs).Values()).To(Equal([]string{"a", "B"}))
+			Expect(cs.Intersection(ci).Values()).To(BeEmpty())
+			Expect(ci.Difference(cs).Values()).To(BeEmpty())
+			Expect(cs.Difference(ci).Values()).To(Equal([]string{"A", "b"}))
+			set := ci.Clone()
+			set.DifferenceInPlace(sets.NewSorted("b"))
+			Expect(set.Values()).To(Equal([]string{"a"}))
+		})
+	})
+
+	Describe("tests for conversions", func() {
+		It("should convert between sets and sorted sets", func() {
+			Expect(sets.ToSorted(sets.New(3, 1, 2)).Values()).To(Equal([]int{1, 2, 3}))
+			Expect(sets.ToSorted(sets.Set[int]{}).Values()).To(BeEmpty())
+			Expect(sets.ToSortedBy(sets.New(3, 1, 2), func(x, y int) bool { return x < y }).Values()).To(Equal([]int{3, 2, 1}))
+			Expect(sets.Equal(sets.FromSorted(setB), sets.New(2, 3, 4, 5))).To(BeTrue())
+		})
+	})
+})
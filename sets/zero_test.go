@@ -0,0 +1,106 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sets_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/sets"
+)
+
+var _ = Describe("sets (zero value)", func() {
+	var zeroSet sets.Set[int]
+	var setA sets.Set[int]
+
+	BeforeEach(func() {
+		zeroSet = sets.Set[int]{}
+		setA = sets.New(1, 2, 3)
+	})
+
+	Describe("tests for read functions", func() {
+		It("should behave like an empty set", func() {
+			Expect(sets.Len(zeroSet)).To(Equal(0))
+			Expect(sets.Values(zeroSet)).To(Equal([]int{}))
+			Expect(sets.Contains(zeroSet, 1)).To(BeFalse())
+			Expect(sets.Equal(zeroSet, sets.New[int]())).To(BeTrue())
+			Expect(sets.Equal(sets.New[int](), zeroSet)).To(BeTrue())
+			Expect(sets.Equal(zeroSet, setA)).To(BeFalse())
+			Expect(sets.Len(sets.Clone(zeroSet))).To(Equal(0))
+			for range sets.All(zeroSet) {
+				Fail("unexpected element")
+			}
+		})
+	})
+
+	Describe("tests for Add()", func() {
+		It("should initialize the set", func() {
+			sets.Add(&zeroSet, 1)
+			Expect(sets.Values(zeroSet)).To(ConsistOf(1))
+		})
+	})
+
+	Describe("tests for Delete()", func() {
+		It("should leave the set empty", func() {
+			sets.Delete(zeroSet, 1)
+			Expect(sets.Len(zeroSet)).To(Equal(0))
+		})
+	})
+
+	Describe("tests for the algebra functions", func() {
+		It("should treat the zero value as an empty set", func() {
+			Expect(sets.Values(sets.Union(zeroSet, setA))).To(ConsistOf(1, 2, 3))
+			Expect(sets.Values(sets.Union(zeroSet, zeroSet))).To(BeEmpty())
+			Expect(sets.Values(sets.Intersection(zeroSet, setA))).To(BeEmpty())
+			Expect(sets.Values(sets.Difference(zeroSet, setA))).To(BeEmpty())
+			Expect(sets.Values(sets.Difference(setA, zeroSet))).To(ConsistOf(1, 2, 3))
+			Expect(sets.Values(sets.SymmetricDifference(zeroSet, setA))).To(ConsistOf(1, 2, 3))
+			Expect(sets.IsSubset(zeroSet, setA)).To(BeTrue())
+			Expect(sets.IsSuperset(zeroSet, setA)).To(BeFalse())
+			Expect(sets.IsSuperset(setA, zeroSet)).To(BeTrue())
+			Expect(sets.IsDisjoint(zeroSet, setA)).To(BeTrue())
+		})
+	})
+
+	Describe("tests for UnionInPlace()", func() {
+		It("should initialize the set", func() {
+			sets.UnionInPlace(&zeroSet, setA)
+			Expect(sets.Values(zeroSet)).To(ConsistOf(1, 2, 3))
+		})
+	})
+
+	Describe("tests for IntersectionInPlace()", func() {
+		It("should leave the set empty", func() {
+			sets.IntersectionInPlace(zeroSet, setA)
+			Expect(sets.Len(zeroSet)).To(Equal(0))
+		})
+	})
+
+	Describe("tests for DifferenceInPlace()", func() {
+		It("should leave the set empty", func() {
+			sets.DifferenceInPlace(zeroSet, setA)
+			Expect(sets.Len(zeroSet)).To(Equal(0))
+		})
+	})
+
+	Describe("tests for SymmetricDifferenceInPlace()", func() {
+		It("should initialize the set", func() {
+			sets.SymmetricDifferenceInPlace(&zeroSet, setA)
+			Expect(sets.Values(zeroSet)).To(ConsistOf(1, 2, 3))
+		})
+	})
+
+	Describe("tests for a zero value set embedded in a struct", func() {
+		It("should be usable without initialization", func() {
+			var x struct {
+				s sets.Set[string]
+			}
+			sets.Add(&x.s, "a")
+			sets.Add(&x.s, "b")
+			sets.Delete(x.s, "a")
+			Expect(sets.Values(x.s)).To(ConsistOf("b"))
+		})
+	})
+})
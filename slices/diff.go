@@ -0,0 +1,276 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Kind of an edit operation.
+type EditKind int
+
+const (
+	// Keep the next element of the source slice.
+	EditKeep EditKind = iota
+	// Insert an element.
+	EditInsert
+	// Delete the next element of the source slice.
+	EditDelete
+)
+
+func (k EditKind) String() string {
+	switch k {
+	case EditKeep:
+		return "keep"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("EditKind(%d)", int(k))
+	}
+}
+
+// Edit operation.
+// For EditKeep and EditDelete, Value is the according element of the source slice;
+// for EditInsert, it is the inserted element (of the target slice).
+type Edit[T any] struct {
+	Kind  EditKind
+	Value T
+}
+
+// Edit script, transforming a source slice into a target slice, as returned by Diff() and DiffBy().
+type EditScript[T any] []Edit[T]
+
+// Error returned by Patch() if the edit script does not match the slice it is applied to.
+var ErrEditScriptMismatch = errors.New("edit script does not match slice")
+
+// Number of unchanged elements shown around changes by EditScript.String().
+const diffContext = 3
+
+// Compute minimal edit script transforming slice s into slice t, comparing elements by given equality function
+// (as for EqualBy()). The script consists of keep, delete and insert operations (where, within a block of changes,
+// deletions precede insertions); it is minimal in the sense that the number of deletions and insertions is minimal.
+// Uses Myers' algorithm, taking O((n+m)*d) time and O(d*d) additional memory, where n and m are the lengths of
+// s and t, and d is the number of deletions and insertions.
+// If both inputs are nil or empty, it will return nil.
+func DiffBy[T any](s []T, t []T, f func(T, T) bool) (r EditScript[T]) {
+	// strip common prefix and suffix
+	p := 0
+	for p < len(s) && p < len(t) && f(s[p], t[p]) {
+		p++
+	}
+	q := 0
+	for q < len(s)-p && q < len(t)-p && f(s[len(s)-q-1], t[len(t)-q-1]) {
+		q++
+	}
+	if len(s)+len(t) == 0 {
+		return
+	}
+	r = make(EditScript[T], 0, max(len(s), len(t)))
+	for _, x := range s[:p] {
+		r = append(r, Edit[T]{Kind: EditKeep, Value: x})
+	}
+	r = myers(r, s[p:len(s)-q], t[p:len(t)-q], f)
+	for _, x := range s[len(s)-q:] {
+		r = append(r, Edit[T]{Kind: EditKeep, Value: x})
+	}
+	return
+}
+
+// Compute minimal edit script transforming slice s into slice t, comparing elements by the == operator
+// (see DiffBy() for details).
+// If both inputs are nil or empty, it will return nil.
+func Diff[T comparable](s []T, t []T) EditScript[T] {
+	f := func(x T, y T) bool {
+		return x == y
+	}
+	return DiffBy(s, t, f)
+}
+
+// Apply edit script to slice s, returning a new slice (the input slice remains unchanged).
+// Returns an error (wrapping ErrEditScriptMismatch) if the number of keep and delete operations in the script
+// does not match the length of s; the values of keep and delete operations are not checked against s.
+func Patch[T any](s []T, e EditScript[T]) ([]T, error) {
+	r := make([]T, 0, len(s))
+	i := 0
+	for j, edit := range e {
+		switch edit.Kind {
+		case EditKeep, EditDelete:
+			if i >= len(s) {
+				return nil, fmt.Errorf("%w: edit %d (%s) exceeds slice length %d", ErrEditScriptMismatch, j, edit.Kind, len(s))
+			}
+			if edit.Kind == EditKeep {
+				r = append(r, s[i])
+			}
+			i++
+		case EditInsert:
+			r = append(r, edit.Value)
+		default:
+			return nil, fmt.Errorf("%w: edit %d has invalid kind %s", ErrEditScriptMismatch, j, edit.Kind)
+		}
+	}
+	if i < len(s) {
+		return nil, fmt.Errorf("%w: edit script covers %d of %d elements", ErrEditScriptMismatch, i, len(s))
+	}
+	return r, nil
+}
+
+// Check if edit script contains any insert or delete operations.
+func (e EditScript[T]) HasChanges() bool {
+	for _, edit := range e {
+		if edit.Kind != EditKeep {
+			return true
+		}
+	}
+	return false
+}
+
+// Format edit script in the style of a unified diff, with one line per element (formatted with %v),
+// prefixed by ' ' (keep), '-' (delete) or '+' (insert). Unchanged elements are only shown around changes,
+// grouped into hunks with headers such as "@@ -1,4 +1,5 @@" (line numbers starting at 1).
+// If the script contains no changes, an empty string is returned.
+func (e EditScript[T]) String() string {
+	var b strings.Builder
+	// position of each edit in the source and target slice
+	is, js := make([]int, len(e)+1), make([]int, len(e)+1)
+	for k, edit := range e {
+		is[k+1], js[k+1] = is[k], js[k]
+		if edit.Kind != EditInsert {
+			is[k+1]++
+		}
+		if edit.Kind != EditDelete {
+			js[k+1]++
+		}
+	}
+	for k := 0; k < len(e); {
+		if e[k].Kind == EditKeep {
+			k++
+			continue
+		}
+		// extend hunk as long as changes are separated by at most 2*diffContext unchanged elements
+		lo, hi := max(k-diffContext, 0), k
+		for l := k; l < len(e) && l-hi <= 2*diffContext; l++ {
+			if e[l].Kind != EditKeep {
+				hi = l + 1
+			}
+		}
+		hi = min(hi+diffContext, len(e))
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", formatHunkRange(is[lo], is[hi]-is[lo]), formatHunkRange(js[lo], js[hi]-js[lo]))
+		for _, edit := range e[lo:hi] {
+			switch edit.Kind {
+			case EditKeep:
+				b.WriteByte(' ')
+			case EditInsert:
+				b.WriteByte('+')
+			case EditDelete:
+				b.WriteByte('-')
+			default:
+				b.WriteByte('?')
+			}
+			fmt.Fprintf(&b, "%v\n", edit.Value)
+		}
+		k = hi
+	}
+	return b.String()
+}
+
+// Format hunk range as in unified diffs (start is zero-based).
+func formatHunkRange(start int, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
+
+// Myers' algorithm; appends the edits transforming s into t to r.
+func myers[T any](r EditScript[T], s []T, t []T, f func(T, T) bool) EditScript[T] {
+	n, m := len(s), len(t)
+	// v[k+n+m] is the furthest x reached on diagonal k = x-y
+	offset := n + m
+	v := make([]int, 2*(n+m)+2)
+	// trace[d] is a copy of v[offset-d+1:offset+d] before step d (that is, after step d-1), for d >= 1
+	trace := make([][]int, 1)
+	d := 0
+	for ; ; d++ {
+		if d > 0 {
+			trace = append(trace, append([]int(nil), v[offset-d+1:offset+d]...))
+		}
+		done := false
+		for k := -d; k <= d && !done; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && f(s[x], t[y]) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			done = x >= n && y >= m
+		}
+		if done {
+			break
+		}
+	}
+	// backtrack, collecting edits in reverse order
+	l := len(r)
+	x, y := n, m
+	for ; d > 0; d-- {
+		w := trace[d]
+		k := x - y
+		var pk int
+		if k == -d || (k != d && w[k-1+d-1] < w[k+1+d-1]) {
+			pk = k + 1
+		} else {
+			pk = k - 1
+		}
+		px := w[pk+d-1]
+		py := px - pk
+		for x > px && y > py {
+			x--
+			y--
+			r = append(r, Edit[T]{Kind: EditKeep, Value: s[x]})
+		}
+		if x == px {
+			y--
+			r = append(r, Edit[T]{Kind: EditInsert, Value: t[y]})
+		} else {
+			x--
+			r = append(r, Edit[T]{Kind: EditDelete, Value: s[x]})
+		}
+	}
+	for x > 0 {
+		x--
+		r = append(r, Edit[T]{Kind: EditKeep, Value: s[x]})
+	}
+	for i, j := l, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	// within each block of changes, move deletions before insertions
+	for i := l; i < len(r); {
+		if r[i].Kind == EditKeep {
+			i++
+			continue
+		}
+		j := i
+		for j < len(r) && r[j].Kind != EditKeep {
+			j++
+		}
+		stableSort(r[i:j], func(x, y Edit[T]) bool { return x.Kind == EditInsert && y.Kind == EditDelete })
+		i = j
+	}
+	return r
+}
@@ -0,0 +1,168 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices_test
+
+import (
+	"math/rand"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("slices (diff)", func() {
+	keep := func(x string) slices.Edit[string] {
+		return slices.Edit[string]{Kind: slices.EditKeep, Value: x}
+	}
+	insert := func(x string) slices.Edit[string] {
+		return slices.Edit[string]{Kind: slices.EditInsert, Value: x}
+	}
+	del := func(x string) slices.Edit[string] {
+		return slices.Edit[string]{Kind: slices.EditDelete, Value: x}
+	}
+	// length of longest common subsequence (by dynamic programming)
+	lcs := func(s []int, t []int) int {
+		l := make([][]int, len(s)+1)
+		for i := range l {
+			l[i] = make([]int, len(t)+1)
+		}
+		for i := len(s) - 1; i >= 0; i-- {
+			for j := len(t) - 1; j >= 0; j-- {
+				if s[i] == t[j] {
+					l[i][j] = l[i+1][j+1] + 1
+				} else {
+					l[i][j] = max(l[i+1][j], l[i][j+1])
+				}
+			}
+		}
+		return l[0][0]
+	}
+	randomSlice := func(n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = rand.Intn(4)
+		}
+		return s
+	}
+
+	Describe("tests for Diff()", func() {
+		Context("with nil or empty slices", func() {
+			It("should return nil", func() {
+				Expect(slices.Diff[string](nil, nil)).To(BeNil())
+				Expect(slices.Diff([]string{}, nil)).To(BeNil())
+			})
+		})
+		Context("with equal slices", func() {
+			It("should only keep elements", func() {
+				e := slices.Diff([]string{"a", "b"}, []string{"a", "b"})
+				Expect(e).To(Equal(slices.EditScript[string]{keep("a"), keep("b")}))
+				Expect(e.HasChanges()).To(BeFalse())
+			})
+		})
+		Context("with an empty source or target slice", func() {
+			It("should insert or delete all elements", func() {
+				Expect(slices.Diff(nil, []string{"a", "b"})).To(Equal(slices.EditScript[string]{insert("a"), insert("b")}))
+				Expect(slices.Diff([]string{"a", "b"}, []string{})).To(Equal(slices.EditScript[string]{del("a"), del("b")}))
+			})
+		})
+		Context("with different slices", func() {
+			It("should return a minimal edit script", func() {
+				e := slices.Diff(strings.Split("abcabba", ""), strings.Split("cbabac", ""))
+				Expect(e.HasChanges()).To(BeTrue())
+				Expect(slices.Count(e, func(e slices.Edit[string]) bool { return e.Kind != slices.EditKeep })).To(Equal(5))
+				Expect(slices.Diff([]string{"a", "b", "c"}, []string{"a", "x", "c"})).To(Equal(slices.EditScript[string]{keep("a"), del("b"), insert("x"), keep("c")}))
+			})
+		})
+		Context("with random slices", func() {
+			It("should return a minimal edit script, transforming the source into the target", func() {
+				for i := 0; i < 500; i++ {
+					s, t := randomSlice(rand.Intn(20)), randomSlice(rand.Intn(20))
+					e := slices.Diff(s, t)
+					r, err := slices.Patch(s, e)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(slices.Equal(r, t)).To(BeTrue())
+					Expect(slices.Count(e, func(e slices.Edit[int]) bool { return e.Kind != slices.EditKeep })).To(Equal(len(s) + len(t) - 2*lcs(s, t)))
+					for j := 1; j < len(e); j++ {
+						Expect(e[j-1].Kind == slices.EditInsert && e[j].Kind == slices.EditDelete).To(BeFalse())
+					}
+				}
+			})
+		})
+	})
+
+	Describe("tests for DiffBy()", func() {
+		It("should compare by the given equality function, keeping the source elements", func() {
+			e := slices.DiffBy([]string{"a", "B", "c"}, []string{"A", "b", "d"}, strings.EqualFold)
+			Expect(e).To(Equal(slices.EditScript[string]{keep("a"), keep("B"), del("c"), insert("d")}))
+		})
+	})
+
+	Describe("tests for Patch()", func() {
+		Context("with a matching edit script", func() {
+			It("should apply the script, and leave the input unchanged", func() {
+				s := []string{"a", "b", "c"}
+				r, err := slices.Patch(s, slices.EditScript[string]{insert("x"), keep("a"), del("b"), keep("c"), insert("y")})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal([]string{"x", "a", "c", "y"}))
+				Expect(s).To(Equal([]string{"a", "b", "c"}))
+			})
+		})
+		Context("with a non-matching edit script", func() {
+			It("should fail", func() {
+				_, err := slices.Patch([]string{"a"}, slices.EditScript[string]{keep("a"), del("b")})
+				Expect(err).To(MatchError(slices.ErrEditScriptMismatch))
+				Expect(err).To(MatchError("edit script does not match slice: edit 1 (delete) exceeds slice length 1"))
+				_, err = slices.Patch([]string{"a", "b"}, slices.EditScript[string]{keep("a")})
+				Expect(err).To(MatchError("edit script does not match slice: edit script covers 1 of 2 elements"))
+				_, err = slices.Patch([]string{"a"}, slices.EditScript[string]{{Kind: 7, Value: "a"}})
+				Expect(err).To(MatchError("edit script does not match slice: edit 0 has invalid kind EditKind(7)"))
+			})
+		})
+	})
+
+	Describe("tests for String()", func() {
+		Context("without changes", func() {
+			It("should return an empty string", func() {
+				Expect(slices.Diff([]int{1, 2}, []int{1, 2}).String()).To(Equal(""))
+			})
+		})
+		Context("with changes", func() {
+			It("should return a unified diff", func() {
+				s := strings.Split("abcdefghijklmnopqrst", "")
+				t := strings.Split("abXdefghijklmnopqrsY", "")
+				t = append([]string{"0"}, t...)
+				Expect(slices.Diff(s, t).String()).To(Equal(strings.Join([]string{
+					"@@ -1,6 +1,7 @@",
+					"+0",
+					" a",
+					" b",
+					"-c",
+					"+X",
+					" d",
+					" e",
+					" f",
+					"@@ -17,4 +18,4 @@",
+					" q",
+					" r",
+					" s",
+					"-t",
+					"+Y",
+					"",
+				}, "\n")))
+				Expect(slices.Diff([]string{"a"}, []string{}).String()).To(Equal("@@ -1 +0,0 @@\n-a\n"))
+			})
+		})
+	})
+
+	Describe("tests for EditKind.String()", func() {
+		It("should return the name of the kind", func() {
+			Expect(slices.EditKeep.String()).To(Equal("keep"))
+			Expect(slices.EditInsert.String()).To(Equal("insert"))
+			Expect(slices.EditDelete.String()).To(Equal("delete"))
+		})
+	})
+})
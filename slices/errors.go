@@ -0,0 +1,184 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices
+
+import "fmt"
+
+// Error returned by the error-returning variants of the functions in this package (such as CollectE()),
+// wrapping the error returned by the callback function, along with the index of the failing element.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %s", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// Variant of Collect() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, the returned slice is nil.
+func CollectE[S any, T any](s []S, f func(S) (T, error)) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	r := make([]T, len(s))
+	for i, x := range s {
+		y, err := f(x)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		r[i] = y
+	}
+	return r, nil
+}
+
+// Variant of Select() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, the returned slice is nil.
+func SelectE[T any](s []T, f func(T) (bool, error)) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	r := make([]T, 0)
+	for i, x := range s {
+		ok, err := f(x)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			r = append(r, x)
+		}
+	}
+	return r, nil
+}
+
+// Variant of UniqBy() for mapper functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, the returned slice is nil.
+func UniqByE[S any, T comparable](s []S, f func(S) (T, error)) ([]S, error) {
+	if s == nil {
+		return nil, nil
+	}
+	r := make([]S, 0)
+	m := make(map[T]struct{})
+	for i, x := range s {
+		y, err := f(x)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if _, ok := m[y]; !ok {
+			m[y] = struct{}{}
+			r = append(r, x)
+		}
+	}
+	return r, nil
+}
+
+// Variant of SortBy() for comparator functions which may fail.
+// As for SortBy(), a nil input yields nil, and otherwise a new slice is returned (never the input slice itself).
+// After the first error, the comparator function is not called anymore; the error is returned
+// (wrapped into an *IndexError, referring to the first of the two compared elements); in that case, the returned slice is nil.
+func SortByE[T any](s []T, f func(x, y T) (bool, error)) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var err error
+	g := func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		var r bool
+		if r, err = f(s[i], s[j]); err != nil {
+			err = &IndexError{Index: i, Err: err}
+		}
+		return r
+	}
+	indices := make([]int, len(s))
+	for i := range indices {
+		indices[i] = i
+	}
+	indices = SortBy(indices, g)
+	if err != nil {
+		return nil, err
+	}
+	return Collect(indices, func(i int) T { return s[i] }), nil
+}
+
+// Variant of EqualBy() for equality functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, false is returned.
+func EqualByE[S any, T any](s []S, t []T, f func(S, T) (bool, error)) (bool, error) {
+	if len(s) != len(t) {
+		return false, nil
+	}
+	for i := 0; i < len(s); i++ {
+		ok, err := f(s[i], t[i])
+		if err != nil {
+			return false, &IndexError{Index: i, Err: err}
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Variant of Any() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, false is returned.
+func AnyE[T any](s []T, f func(T) (bool, error)) (bool, error) {
+	for i, x := range s {
+		ok, err := f(x)
+		if err != nil {
+			return false, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Variant of All() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, false is returned.
+func AllE[T any](s []T, f func(T) (bool, error)) (bool, error) {
+	for i, x := range s {
+		ok, err := f(x)
+		if err != nil {
+			return false, &IndexError{Index: i, Err: err}
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Variant of None() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, false is returned.
+func NoneE[T any](s []T, f func(T) (bool, error)) (bool, error) {
+	ok, err := AnyE(s, f)
+	if err != nil {
+		return false, err
+	}
+	return !ok, nil
+}
+
+// Variant of Count() for functions which may fail.
+// Stops at the first error, and returns it (wrapped into an *IndexError); in that case, zero is returned.
+func CountE[T any](s []T, f func(T) (bool, error)) (int, error) {
+	c := 0
+	for i, x := range s {
+		ok, err := f(x)
+		if err != nil {
+			return 0, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			c++
+		}
+	}
+	return c, nil
+}
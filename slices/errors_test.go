@@ -0,0 +1,212 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("slices (error-returning variants)", func() {
+	var errFailed error
+	var nilSlice []int
+	var emptySlice []int
+	var sliceD []int
+	var calls int
+
+	// predicate selecting odd numbers, failing for the given element, and counting its invocations
+	failOn := func(y int) func(int) (bool, error) {
+		return func(x int) (bool, error) {
+			calls++
+			if x == y {
+				return false, errFailed
+			}
+			return x%2 != 0, nil
+		}
+	}
+	expectIndexError := func(err error, i int) {
+		var indexError *slices.IndexError
+		Expect(errors.As(err, &indexError)).To(BeTrue())
+		Expect(indexError.Index).To(Equal(i))
+		Expect(err).To(MatchError(errFailed))
+	}
+
+	BeforeEach(func() {
+		errFailed = errors.New("failed")
+		nilSlice = nil
+		emptySlice = []int{}
+		sliceD = []int{9, 6, 5, 6, 3, 7, 7, 1, 2, 8}
+		calls = 0
+	})
+
+	AfterEach(func() {
+		Expect(sliceD).To(Equal([]int{9, 6, 5, 6, 3, 7, 7, 1, 2, 8}))
+	})
+
+	Describe("tests for CollectE()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.CollectE(nilSlice, func(int) (int, error) { return 0, nil })).To(Equal(nilSlice))
+			})
+		})
+		Context("with an empty slice", func() {
+			It("should return an empty slice", func() {
+				Expect(slices.CollectE(emptySlice, func(int) (int, error) { return 0, nil })).To(Equal(emptySlice))
+			})
+		})
+		Context("with a succeeding function", func() {
+			It("should match Collect()", func() {
+				Expect(slices.CollectE(sliceD, func(x int) (int, error) { return 2 * x, nil })).To(Equal(slices.Collect(sliceD, func(x int) int { return 2 * x })))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should stop at the first error", func() {
+				r, err := slices.CollectE(sliceD, failOn(5))
+				Expect(r).To(BeNil())
+				expectIndexError(err, 2)
+				Expect(calls).To(Equal(3))
+			})
+		})
+	})
+
+	Describe("tests for SelectE()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.SelectE(nilSlice, failOn(0))).To(Equal(nilSlice))
+			})
+		})
+		Context("with a succeeding function", func() {
+			It("should match Select()", func() {
+				Expect(slices.SelectE(sliceD, failOn(0))).To(Equal([]int{9, 5, 3, 7, 7, 1}))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should stop at the first error", func() {
+				r, err := slices.SelectE(sliceD, failOn(6))
+				Expect(r).To(BeNil())
+				expectIndexError(err, 1)
+				Expect(calls).To(Equal(2))
+			})
+		})
+	})
+
+	Describe("tests for UniqByE()", func() {
+		Context("with a succeeding function", func() {
+			It("should match UniqBy()", func() {
+				f := func(x int) (int, error) { return x % 3, nil }
+				Expect(slices.UniqByE(sliceD, f)).To(Equal([]int{9, 5, 7}))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				r, err := slices.UniqByE(sliceD, failOn(3))
+				Expect(r).To(BeNil())
+				expectIndexError(err, 4)
+			})
+		})
+	})
+
+	Describe("tests for SortByE()", func() {
+		Context("with an empty slice", func() {
+			It("should return an empty slice", func() {
+				Expect(slices.SortByE(emptySlice, func(int, int) (bool, error) { return false, errFailed })).To(Equal(emptySlice))
+			})
+		})
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.SortByE(nil, func(int, int) (bool, error) { return false, errFailed })).To(BeNil())
+			})
+		})
+		Context("with a slice of length one", func() {
+			It("should return a new slice", func() {
+				s := []int{5}
+				r, err := slices.SortByE(s, func(int, int) (bool, error) { return false, errFailed })
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal(s))
+				r[0] = 6
+				Expect(s).To(Equal([]int{5}))
+			})
+		})
+		Context("with a succeeding function", func() {
+			It("should match SortBy()", func() {
+				Expect(slices.SortByE(sliceD, func(x, y int) (bool, error) { return x > y, nil })).To(Equal(slices.Sort(sliceD)))
+			})
+		})
+		Context("with a failing function", func() {
+			It("should not call the function after the first error", func() {
+				f := func(x, y int) (bool, error) {
+					calls++
+					if x == 3 || y == 3 {
+						return false, errFailed
+					}
+					return x > y, nil
+				}
+				r, err := slices.SortByE(sliceD, f)
+				Expect(r).To(BeNil())
+				Expect(err).To(MatchError(errFailed))
+				n := calls
+				_, err = slices.SortByE([]int{3, 1}, f)
+				Expect(err).To(MatchError(errFailed))
+				Expect(calls).To(Equal(n + 1))
+			})
+		})
+	})
+
+	Describe("tests for EqualByE()", func() {
+		Context("with different lengths", func() {
+			It("should return false", func() {
+				Expect(slices.EqualByE(sliceD, emptySlice, func(int, int) (bool, error) { return true, errFailed })).To(BeFalse())
+			})
+		})
+		Context("with nil/empty slices", func() {
+			It("should return true", func() {
+				Expect(slices.EqualByE(nilSlice, emptySlice, func(int, int) (bool, error) { return false, errFailed })).To(BeTrue())
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				f := func(x, y int) (bool, error) {
+					if x == 5 {
+						return false, errFailed
+					}
+					return x == y, nil
+				}
+				_, err := slices.EqualByE(sliceD, sliceD, f)
+				expectIndexError(err, 2)
+			})
+		})
+	})
+
+	Describe("tests for AnyE(), AllE(), NoneE() and CountE()", func() {
+		Context("with a succeeding function", func() {
+			It("should match Any(), All(), None() and Count()", func() {
+				Expect(slices.AnyE(sliceD, failOn(0))).To(BeTrue())
+				Expect(slices.AllE(sliceD, failOn(0))).To(BeFalse())
+				Expect(slices.NoneE(sliceD, failOn(0))).To(BeFalse())
+				Expect(slices.CountE(sliceD, failOn(0))).To(Equal(6))
+				Expect(slices.AnyE(nilSlice, failOn(0))).To(BeFalse())
+				Expect(slices.AllE(nilSlice, failOn(0))).To(BeTrue())
+				Expect(slices.NoneE(nilSlice, failOn(0))).To(BeTrue())
+			})
+		})
+		Context("with a failing function", func() {
+			It("should return the error", func() {
+				_, err := slices.AnyE([]int{2, 4, 6}, failOn(4))
+				expectIndexError(err, 1)
+				_, err = slices.AllE([]int{1, 3, 5}, failOn(5))
+				expectIndexError(err, 2)
+				_, err = slices.NoneE([]int{2, 4}, failOn(2))
+				expectIndexError(err, 0)
+				c, err := slices.CountE(sliceD, failOn(8))
+				Expect(c).To(Equal(0))
+				expectIndexError(err, 9)
+			})
+		})
+	})
+})
@@ -0,0 +1,107 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices
+
+import "fmt"
+
+// Error returned by KeyBy() if the key function produces the same key for two elements.
+type DuplicateKeyError[K comparable] struct {
+	Key           K
+	Index         int
+	PreviousIndex int
+}
+
+func (e *DuplicateKeyError[K]) Error() string {
+	return fmt.Sprintf("duplicate key %v at index %d (previously seen at index %d)", e.Key, e.Index, e.PreviousIndex)
+}
+
+// Group elements of slice by the keys returned by given function.
+// The elements within each group preserve their order in the input slice.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty map.
+func GroupBy[T any, K comparable](s []T, f func(T) K) map[K][]T {
+	if s == nil {
+		return nil
+	}
+	m := make(map[K][]T)
+	for _, x := range s {
+		k := f(x)
+		m[k] = append(m[k], x)
+	}
+	return m
+}
+
+// Split slice into the elements for which given function returns true, and the ones for which it returns false.
+// Both result slices preserve the order of the input slice.
+// If the input is nil, it will return nil, nil; otherwise, empty results will be returned as empty slices.
+func Partition[T any](s []T, f func(T) bool) (r []T, t []T) {
+	if s == nil {
+		return
+	}
+	r = make([]T, 0)
+	t = make([]T, 0)
+	for _, x := range s {
+		if f(x) {
+			r = append(r, x)
+		} else {
+			t = append(t, x)
+		}
+	}
+	return
+}
+
+// Split slice into consecutive chunks of length n (the last chunk may be shorter).
+// The returned chunks share the underlying array with the input slice, but their capacity is limited to their length
+// (so appending to a chunk does not affect the input slice).
+// If the input is nil, it will return nil; if the input is empty, it will return an empty slice. Panics if n is zero.
+func Chunk[T any](s []T, n uint) (r [][]T) {
+	if n == 0 {
+		panic("chunk size must be positive")
+	}
+	if s == nil {
+		return
+	}
+	r = make([][]T, 0)
+	m := int(min(n, uint(len(s))))
+	for i := 0; i < len(s); i += m {
+		j := min(i+m, len(s))
+		r = append(r, s[i:j:j])
+	}
+	return
+}
+
+// Index slice by the keys returned by given function.
+// If the function returns the same key for two elements, a *DuplicateKeyError is returned (along with a nil map),
+// instead of silently keeping one of the elements.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty map.
+func KeyBy[T any, K comparable](s []T, f func(T) K) (map[K]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	m := make(map[K]T)
+	seen := make(map[K]int)
+	for i, x := range s {
+		k := f(x)
+		if j, ok := seen[k]; ok {
+			return nil, &DuplicateKeyError[K]{Key: k, Index: i, PreviousIndex: j}
+		}
+		seen[k] = i
+		m[k] = x
+	}
+	return m, nil
+}
+
+// Count elements of slice by the keys returned by given function.
+// If the input is nil, it will return nil; if the input is empty, it will return an empty map.
+func CountBy[T any, K comparable](s []T, f func(T) K) map[K]int {
+	if s == nil {
+		return nil
+	}
+	m := make(map[K]int)
+	for _, x := range s {
+		m[f(x)]++
+	}
+	return m
+}
@@ -0,0 +1,141 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("slices (grouping)", func() {
+	var nilSlice []string
+	var emptySlice []string
+	var words []string
+
+	length := func(x string) int {
+		return len(x)
+	}
+
+	BeforeEach(func() {
+		emptySlice = []string{}
+		words = []string{"a", "bb", "c", "ddd", "ee", "f"}
+	})
+
+	Describe("tests for GroupBy()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.GroupBy(nilSlice, length)).To(BeNil())
+			})
+		})
+		Context("with an empty slice", func() {
+			It("should return an empty map", func() {
+				m := slices.GroupBy(emptySlice, length)
+				Expect(m).NotTo(BeNil())
+				Expect(m).To(BeEmpty())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should group the elements, preserving their order", func() {
+				Expect(slices.GroupBy(words, length)).To(Equal(map[int][]string{1: {"a", "c", "f"}, 2: {"bb", "ee"}, 3: {"ddd"}}))
+			})
+		})
+	})
+
+	Describe("tests for Partition()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil, nil", func() {
+				r, t := slices.Partition(nilSlice, func(string) bool { return true })
+				Expect(r).To(BeNil())
+				Expect(t).To(BeNil())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should split the elements, preserving their order", func() {
+				r, t := slices.Partition(words, func(x string) bool { return len(x) == 1 })
+				Expect(r).To(Equal([]string{"a", "c", "f"}))
+				Expect(t).To(Equal([]string{"bb", "ddd", "ee"}))
+				r, t = slices.Partition(words, func(string) bool { return true })
+				Expect(r).To(Equal(words))
+				Expect(t).To(Equal([]string{}))
+			})
+		})
+	})
+
+	Describe("tests for Chunk()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.Chunk(nilSlice, 2)).To(BeNil())
+			})
+		})
+		Context("with an empty slice", func() {
+			It("should return an empty slice", func() {
+				Expect(slices.Chunk(emptySlice, 2)).To(Equal([][]string{}))
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should return chunks of the given size", func() {
+				Expect(slices.Chunk(words, 4)).To(Equal([][]string{{"a", "bb", "c", "ddd"}, {"ee", "f"}}))
+				Expect(slices.Chunk(words, 3)).To(Equal([][]string{{"a", "bb", "c"}, {"ddd", "ee", "f"}}))
+				Expect(slices.Chunk(words, 10)).To(Equal([][]string{words}))
+			})
+			It("should not let appends to a chunk affect the input slice", func() {
+				r := slices.Chunk(words, 2)
+				_ = append(r[0], "x")
+				Expect(words[2]).To(Equal("c"))
+			})
+		})
+		Context("with chunk size zero", func() {
+			It("should panic", func() {
+				Expect(func() { slices.Chunk(words, 0) }).To(Panic())
+			})
+		})
+	})
+
+	Describe("tests for KeyBy()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				m, err := slices.KeyBy(nilSlice, length)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(m).To(BeNil())
+			})
+		})
+		Context("with unique keys", func() {
+			It("should return the index", func() {
+				m, err := slices.KeyBy(words, func(x string) string { return x[:1] })
+				Expect(err).NotTo(HaveOccurred())
+				Expect(m).To(Equal(map[string]string{"a": "a", "b": "bb", "c": "c", "d": "ddd", "e": "ee", "f": "f"}))
+			})
+		})
+		Context("with duplicate keys", func() {
+			It("should return an error", func() {
+				m, err := slices.KeyBy(words, length)
+				Expect(m).To(BeNil())
+				var duplicateKeyError *slices.DuplicateKeyError[int]
+				Expect(errors.As(err, &duplicateKeyError)).To(BeTrue())
+				Expect(duplicateKeyError.Key).To(Equal(1))
+				Expect(duplicateKeyError.Index).To(Equal(2))
+				Expect(duplicateKeyError.PreviousIndex).To(Equal(0))
+				Expect(err).To(MatchError("duplicate key 1 at index 2 (previously seen at index 0)"))
+			})
+		})
+	})
+
+	Describe("tests for CountBy()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.CountBy(nilSlice, length)).To(BeNil())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should count the elements per key", func() {
+				Expect(slices.CountBy(words, length)).To(Equal(map[int]int{1: 3, 2: 2, 3: 1}))
+			})
+		})
+	})
+})
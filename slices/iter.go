@@ -0,0 +1,113 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices
+
+import "iter"
+
+// Get all elements of slice as sequence.
+// If the input is nil or empty, the returned sequence will not yield any elements.
+func Seq[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, x := range s {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Build slice from sequence.
+// If the sequence does not yield any elements, an empty (non-nil) slice will be returned.
+// Note that a sequence does not tell whether it was produced from a nil or an empty slice; therefore, for example,
+// FromSeq(SelectSeq(nil, f)) returns an empty slice, whereas Select(nil, f) returns nil.
+func FromSeq[T any](seq iter.Seq[T]) []T {
+	r := make([]T, 0)
+	for x := range seq {
+		r = append(r, x)
+	}
+	return r
+}
+
+// Lazy variant of Remove().
+// The returned sequence yields all elements of the slice which are not equal to the given element.
+func RemoveSeq[T comparable](s []T, x T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, y := range s {
+			if y == x {
+				continue
+			}
+			if !yield(y) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of Reverse().
+// The returned sequence yields the elements of the slice in reverse order.
+func ReverseSeq[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of UniqBy().
+// The returned sequence yields the first occurrence of each element (as identified by the mapper function f),
+// preserving order.
+func UniqBySeq[S any, T comparable](s []S, f func(S) T) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		m := make(map[T]struct{})
+		for _, x := range s {
+			y := f(x)
+			if _, ok := m[y]; ok {
+				continue
+			}
+			m[y] = struct{}{}
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of Uniq().
+// The returned sequence yields the first occurrence of each element, preserving order.
+func UniqSeq[T comparable](s []T) iter.Seq[T] {
+	f := func(x T) T {
+		return x
+	}
+	return UniqBySeq(s, f)
+}
+
+// Lazy variant of Collect().
+// The returned sequence yields the elements of the slice mapped through the provided function f.
+// Note that f is evaluated on demand, that is each time the sequence is iterated.
+func CollectSeq[S any, T any](s []S, f func(S) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, x := range s {
+			if !yield(f(x)) {
+				return
+			}
+		}
+	}
+}
+
+// Lazy variant of Select().
+// The returned sequence yields those elements of the slice, for which the provided function f evaluates to true.
+// Note that f is evaluated on demand, that is each time the sequence is iterated.
+func SelectSeq[T any](s []T, f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, x := range s {
+			if f(x) && !yield(x) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("slices (iterators)", func() {
+	var nilSlice []int
+	var emptySlice []int
+	var sliceB []int
+	var sliceD []int
+	var sliceF []float64
+
+	BeforeEach(func() {
+		nilSlice = nil
+		emptySlice = []int{}
+		sliceB = []int{1, 2, 3}
+		sliceD = []int{9, 6, 5, 6, 3, 7, 7, 1, 2, 8}
+		sliceF = []float64{2.1, 1.5, 2.6, 3.4, 1.9}
+	})
+
+	Describe("tests for Seq() and FromSeq()", func() {
+		Context("with a nil slice", func() {
+			It("should return an empty slice", func() {
+				Expect(slices.FromSeq(slices.Seq(nilSlice))).To(Equal(emptySlice))
+			})
+		})
+		Context("with a more complex slice", func() {
+			It("should return the slice unchanged", func() {
+				Expect(slices.FromSeq(slices.Seq(sliceD))).To(Equal(sliceD))
+			})
+		})
+		Context("when stopping early", func() {
+			It("should yield the first elements only", func() {
+				var r []int
+				for x := range slices.Seq(sliceD) {
+					if x == 3 {
+						break
+					}
+					r = append(r, x)
+				}
+				Expect(r).To(Equal([]int{9, 6, 5, 6}))
+			})
+		})
+	})
+
+	Describe("tests for RemoveSeq()", func() {
+		Context("with a nil slice", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(slices.RemoveSeq(nilSlice, 1))).To(BeEmpty())
+			})
+		})
+		Context("with a slice containing the element", func() {
+			It("should match Remove()", func() {
+				Expect(slices.FromSeq(slices.RemoveSeq(sliceD, 6))).To(Equal(slices.Remove(sliceD, 6)))
+			})
+		})
+	})
+
+	Describe("tests for ReverseSeq()", func() {
+		Context("with a nil slice", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(slices.ReverseSeq(nilSlice))).To(BeEmpty())
+			})
+		})
+		Context("with a slice of length three", func() {
+			It("should match Reverse()", func() {
+				Expect(slices.FromSeq(slices.ReverseSeq(sliceB))).To(Equal(slices.Reverse(sliceB)))
+			})
+		})
+	})
+
+	Describe("tests for UniqBySeq() and UniqSeq()", func() {
+		Context("with a nil slice", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(slices.UniqSeq(nilSlice))).To(BeEmpty())
+			})
+		})
+		Context("with a more complex slice", func() {
+			It("should match UniqBy() and Uniq()", func() {
+				f := func(x float64) int {
+					return int(x)
+				}
+				Expect(slices.FromSeq(slices.UniqBySeq(sliceF, f))).To(Equal(slices.UniqBy(sliceF, f)))
+				Expect(slices.FromSeq(slices.UniqSeq(sliceD))).To(Equal(slices.Uniq(sliceD)))
+			})
+		})
+	})
+
+	Describe("tests for CollectSeq()", func() {
+		Context("with a nil slice", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(slices.CollectSeq(nilSlice, func(int) int { return -1 }))).To(BeEmpty())
+			})
+		})
+		Context("with a more complex slice", func() {
+			It("should match Collect()", func() {
+				f := func(x float64) int {
+					return int(x)
+				}
+				Expect(slices.FromSeq(slices.CollectSeq(sliceF, f))).To(Equal(slices.Collect(sliceF, f)))
+			})
+		})
+	})
+
+	Describe("tests for SelectSeq()", func() {
+		Context("with a nil slice", func() {
+			It("should yield nothing", func() {
+				Expect(slices.FromSeq(slices.SelectSeq(nilSlice, func(int) bool { return true }))).To(BeEmpty())
+			})
+			It("should be collected into an empty (non-nil) slice, other than the nil result of Select()", func() {
+				f := func(int) bool { return true }
+				Expect(slices.Select(nilSlice, f)).To(BeNil())
+				Expect(slices.FromSeq(slices.SelectSeq(nilSlice, f))).NotTo(BeNil())
+				Expect(slices.FromSeq(slices.SelectSeq(nilSlice, f))).To(Equal(slices.Select(emptySlice, f)))
+			})
+		})
+		Context("with a more complex slice", func() {
+			It("should match Select()", func() {
+				f := func(x int) bool {
+					return x%2 != 0
+				}
+				Expect(slices.FromSeq(slices.SelectSeq(sliceD, f))).To(Equal(slices.Select(sliceD, f)))
+			})
+		})
+		Context("when stopping early", func() {
+			It("should not evaluate the function on further elements", func() {
+				n := 0
+				f := func(x int) bool {
+					n++
+					return x%2 != 0
+				}
+				for range slices.SelectSeq(sliceD, f) {
+					break
+				}
+				Expect(n).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,152 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices
+
+import (
+	"cmp"
+	"math"
+)
+
+// Numeric constraint.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64
+}
+
+// Return minimal element of slice of orderable elements.
+// Floating point NaN values are considered smaller than other values (as for Sort()).
+// If the input is nil or empty, the zero value and false will be returned.
+func Min[T Orderable](s []T) (T, bool) {
+	return MinFunc(s, cmp.Compare[T])
+}
+
+// Return maximal element of slice of orderable elements.
+// Floating point NaN values are considered smaller than other values (as for Sort()).
+// If the input is nil or empty, the zero value and false will be returned.
+func Max[T Orderable](s []T) (T, bool) {
+	return MaxFunc(s, cmp.Compare[T])
+}
+
+// Return minimal and maximal element of slice of orderable elements.
+// Floating point NaN values are considered smaller than other values (as for Sort()).
+// If the input is nil or empty, zero values and false will be returned.
+func MinMax[T Orderable](s []T) (minimum T, maximum T, ok bool) {
+	for i, x := range s {
+		if i == 0 || cmp.Less(x, minimum) {
+			minimum = x
+		}
+		if i == 0 || cmp.Less(maximum, x) {
+			maximum = x
+		}
+	}
+	return minimum, maximum, len(s) > 0
+}
+
+// Return element of slice with minimal key, as returned by given mapper function;
+// if there are multiple such elements, the first one is returned. The mapper function is called once per element.
+// If the input is nil or empty, the zero value and false will be returned.
+func MinBy[T any, K Orderable](s []T, f func(T) K) (T, bool) {
+	i, ok := argBy(s, f, -1)
+	if !ok {
+		var r T
+		return r, false
+	}
+	return s[i], true
+}
+
+// Return element of slice with maximal key, as returned by given mapper function;
+// if there are multiple such elements, the first one is returned. The mapper function is called once per element.
+// If the input is nil or empty, the zero value and false will be returned.
+func MaxBy[T any, K Orderable](s []T, f func(T) K) (T, bool) {
+	i, ok := argBy(s, f, 1)
+	if !ok {
+		var r T
+		return r, false
+	}
+	return s[i], true
+}
+
+// Return index of the (first) minimal element of slice of orderable elements.
+// If the input is nil or empty, 0 and false will be returned.
+func ArgMin[T Orderable](s []T) (int, bool) {
+	return argBy(s, func(x T) T { return x }, -1)
+}
+
+// Return index of the (first) maximal element of slice of orderable elements.
+// If the input is nil or empty, 0 and false will be returned.
+func ArgMax[T Orderable](s []T) (int, bool) {
+	return argBy(s, func(x T) T { return x }, 1)
+}
+
+// Return sum of all elements of slice.
+// If the input is nil or empty, zero will be returned. Integer overflows are not detected.
+func Sum[T Numeric](s []T) (r T) {
+	for _, x := range s {
+		r += x
+	}
+	return
+}
+
+// Return product of all elements of slice.
+// If the input is nil or empty, one will be returned (the empty product). Integer overflows are not detected.
+func Product[T Numeric](s []T) (r T) {
+	r = 1
+	for _, x := range s {
+		r *= x
+	}
+	return
+}
+
+// Return arithmetic mean of all elements of slice (calculated in floating point arithmetic).
+// If the input is nil or empty, zero and false will be returned.
+func Mean[T Numeric](s []T) (float64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+	r := 0.0
+	for _, x := range s {
+		r += float64(x)
+	}
+	return r / float64(len(s)), true
+}
+
+// Return median of all elements of slice; if the length of the slice is even, the mean of the two middle elements
+// is returned. The input slice remains unchanged.
+// If the input is nil or empty, zero and false will be returned.
+func Median[T Numeric](s []T) (float64, bool) {
+	return Percentile(s, 50)
+}
+
+// Return p-th percentile of all elements of slice, interpolating linearly between the two closest ranks
+// (that is, the 0th percentile is the minimum, the 100th percentile the maximum). The input slice remains unchanged.
+// Floating point NaN values are considered smaller than other values (as for Sort()).
+// If the input is nil or empty, zero and false will be returned. Panics if p is not within [0, 100].
+func Percentile[T Numeric](s []T, p float64) (float64, bool) {
+	if !(p >= 0 && p <= 100) {
+		panic("percentile must be within [0, 100]")
+	}
+	if len(s) == 0 {
+		return 0, false
+	}
+	s = Sort(s)
+	r := p / 100 * float64(len(s)-1)
+	i := int(math.Floor(r))
+	if i == len(s)-1 {
+		return float64(s[i]), true
+	}
+	return float64(s[i]) + (r-float64(i))*(float64(s[i+1])-float64(s[i])), true
+}
+
+// Return index of the first element with minimal (if sign is -1) or maximal (if sign is 1) key.
+func argBy[T any, K Orderable](s []T, f func(T) K, sign int) (i int, ok bool) {
+	var m K
+	for j, x := range s {
+		k := f(x)
+		if j == 0 || cmp.Compare(k, m)*sign > 0 {
+			i, m = j, k
+		}
+	}
+	return i, len(s) > 0
+}
@@ -0,0 +1,186 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("slices (reductions)", func() {
+	var emptySlice []int
+	var sliceD []int
+	var sliceF []float64
+
+	BeforeEach(func() {
+		emptySlice = []int{}
+		sliceD = []int{3, 6, 9, 2, 1, 7, 5, 7, 8, 6}
+		sliceF = []float64{2.5, -1, 4, 0.5}
+	})
+
+	Describe("tests for Min(), Max() and MinMax()", func() {
+		Context("with an empty slice", func() {
+			It("should return zero values and false", func() {
+				x, ok := slices.Min(emptySlice)
+				Expect(x).To(Equal(0))
+				Expect(ok).To(BeFalse())
+				x, ok = slices.Max[int](nil)
+				Expect(x).To(Equal(0))
+				Expect(ok).To(BeFalse())
+				x, y, ok := slices.MinMax(emptySlice)
+				Expect(x).To(Equal(0))
+				Expect(y).To(Equal(0))
+				Expect(ok).To(BeFalse())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should return the minimal and maximal elements", func() {
+				x, ok := slices.Min(sliceD)
+				Expect(x).To(Equal(1))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Max(sliceD)
+				Expect(x).To(Equal(9))
+				Expect(ok).To(BeTrue())
+				x, y, ok := slices.MinMax(sliceD)
+				Expect(x).To(Equal(1))
+				Expect(y).To(Equal(9))
+				Expect(ok).To(BeTrue())
+				s, ok := slices.Min([]string{"b", "a", "c"})
+				Expect(s).To(Equal("a"))
+				Expect(ok).To(BeTrue())
+			})
+		})
+		Context("with a slice containing NaN", func() {
+			It("should consider NaN smaller than other values", func() {
+				x, ok := slices.Min([]float64{1, math.NaN(), 2})
+				Expect(math.IsNaN(x)).To(BeTrue())
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Max([]float64{1, math.NaN(), 2})
+				Expect(x).To(Equal(2.0))
+				Expect(ok).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("tests for MinBy() and MaxBy()", func() {
+		Context("with an empty slice", func() {
+			It("should return the zero value and false", func() {
+				x, ok := slices.MinBy(emptySlice, func(x int) int { return x })
+				Expect(x).To(Equal(0))
+				Expect(ok).To(BeFalse())
+				x, ok = slices.MaxBy(emptySlice, func(x int) int { return x })
+				Expect(x).To(Equal(0))
+				Expect(ok).To(BeFalse())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should return the first element with minimal or maximal key", func() {
+				s := []string{"ccc", "a", "bb", "d", "eee"}
+				x, ok := slices.MinBy(s, func(x string) int { return len(x) })
+				Expect(x).To(Equal("a"))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.MaxBy(s, func(x string) int { return len(x) })
+				Expect(x).To(Equal("ccc"))
+				Expect(ok).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("tests for ArgMin() and ArgMax()", func() {
+		Context("with an empty slice", func() {
+			It("should return 0 and false", func() {
+				i, ok := slices.ArgMin(emptySlice)
+				Expect(i).To(Equal(0))
+				Expect(ok).To(BeFalse())
+				i, ok = slices.ArgMax(emptySlice)
+				Expect(i).To(Equal(0))
+				Expect(ok).To(BeFalse())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should return the index of the first minimal or maximal element", func() {
+				i, ok := slices.ArgMin([]int{2, 1, 3, 1})
+				Expect(i).To(Equal(1))
+				Expect(ok).To(BeTrue())
+				i, ok = slices.ArgMax(sliceD)
+				Expect(i).To(Equal(2))
+				Expect(ok).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("tests for Sum() and Product()", func() {
+		Context("with an empty slice", func() {
+			It("should return the empty sum and product", func() {
+				Expect(slices.Sum(emptySlice)).To(Equal(0))
+				Expect(slices.Product(emptySlice)).To(Equal(1))
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should return sum and product", func() {
+				Expect(slices.Sum(sliceD)).To(Equal(54))
+				Expect(slices.Product([]int{2, 3, 4})).To(Equal(24))
+				Expect(slices.Sum(sliceF)).To(Equal(6.0))
+				Expect(slices.Product(sliceF)).To(Equal(-5.0))
+			})
+		})
+	})
+
+	Describe("tests for Mean(), Median() and Percentile()", func() {
+		Context("with an empty slice", func() {
+			It("should return zero and false", func() {
+				x, ok := slices.Mean(emptySlice)
+				Expect(x).To(Equal(0.0))
+				Expect(ok).To(BeFalse())
+				x, ok = slices.Median(emptySlice)
+				Expect(x).To(Equal(0.0))
+				Expect(ok).To(BeFalse())
+				x, ok = slices.Percentile(emptySlice, 90)
+				Expect(x).To(Equal(0.0))
+				Expect(ok).To(BeFalse())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should return mean, median and percentiles, and leave the input unchanged", func() {
+				x, ok := slices.Mean(sliceD)
+				Expect(x).To(Equal(5.4))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Median(sliceD)
+				Expect(x).To(Equal(6.0))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Median([]int{4, 1, 2})
+				Expect(x).To(Equal(2.0))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Median(sliceF)
+				Expect(x).To(Equal(1.5))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Percentile(sliceD, 0)
+				Expect(x).To(Equal(1.0))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Percentile(sliceD, 100)
+				Expect(x).To(Equal(9.0))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Percentile([]int{10, 20, 30, 40, 50}, 90)
+				Expect(x).To(BeNumerically("~", 46.0, 1e-9))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.Percentile([]int{7}, 30)
+				Expect(x).To(Equal(7.0))
+				Expect(ok).To(BeTrue())
+				Expect(sliceD).To(Equal([]int{3, 6, 9, 2, 1, 7, 5, 7, 8, 6}))
+			})
+		})
+		Context("with an invalid percentile", func() {
+			It("should panic", func() {
+				Expect(func() { slices.Percentile(sliceD, 101) }).To(Panic())
+				Expect(func() { slices.Percentile(sliceD, -1) }).To(Panic())
+				Expect(func() { slices.Percentile(sliceD, math.NaN()) }).To(Panic())
+			})
+		})
+	})
+})
@@ -5,6 +5,8 @@ SPDX-License-Identifier: Apache-2.0
 
 package slices
 
+import stdslices "slices"
+
 // Orderable constraint.
 type Orderable interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64 | ~string
@@ -74,45 +76,97 @@ func Reverse[T any](s []T) (r []T) {
 }
 
 // Sort slice by given comparator function.
-// If the input is nil, it will return nil; otherwise, if the input is empty, it will return an empty slice.
+// If the input is nil, it will return nil; otherwise, it will return a new sorted slice (the input slice remains unchanged,
+// and is never returned itself, not even if its length is 0 or 1).
 // The comparator function f(x,y) must return true if x is larger than y, and false if x is smaller than y;
 // the return value in case of equality does not matter (may be true or false).
+// The sort is stable if f(x,y) returns false in case of equality; it performs O(n*log(n)) comparisons, and allocates
+// (besides the result) a single auxiliary buffer.
+// To sort by a three-way comparator (such as an ordering.Comparator), use SortFunc().
 func SortBy[T any](s []T, f func(x, y T) bool) (r []T) {
-	l := len(s)
-	if l <= 1 {
-		return s
-	}
-	s1 := SortBy(s[0:l/2], f)
-	s2 := SortBy(s[l/2:l], f)
-	r = make([]T, l)
-	i1, i2 := 0, 0
-	for j := 0; j < l; j++ {
-		if i1 >= len(s1) {
-			r[j] = s2[i2]
-			i2++
-		} else if i2 >= len(s2) {
-			r[j] = s1[i1]
-			i1++
-		} else {
-			if f(s1[i1], s2[i2]) {
-				r[j] = s2[i2]
-				i2++
-			} else {
-				r[j] = s1[i1]
-				i1++
-			}
-		}
+	if s == nil {
+		return
 	}
+	r = make([]T, len(s))
+	copy(r, s)
+	stableSort(r, f)
 	return
 }
 
 // Sort slice of orderable elements.
-// If the input is nil, it will return nil; otherwise, if the input is empty, it will return an empty slice.
-func Sort[T Orderable](s []T) []T {
+// If the input is nil, it will return nil; otherwise, it will return a new sorted slice (the input slice remains unchanged,
+// and is never returned itself). Sorting is done in place on the result, without further allocations.
+// Floating point NaN values are ordered before other values.
+func Sort[T Orderable](s []T) (r []T) {
+	if s == nil {
+		return
+	}
+	r = make([]T, len(s))
+	copy(r, s)
+	SortInPlace(r)
+	return
+}
+
+// Sort slice of orderable elements in place (using pattern-defeating quicksort; the sort is not stable).
+// Floating point NaN values are ordered before other values.
+func SortInPlace[T Orderable](s []T) {
+	stdslices.Sort(s)
+}
+
+// Sort slice in place by given three-way comparator function; the sort is stable.
+// The comparator function cmp(x,y) must return a negative number if x is smaller than y, a positive number
+// if x is larger than y, and zero in case of equality.
+// Performs O(n*log(n)) comparisons, and allocates a single auxiliary buffer.
+func SortStableFunc[T any](s []T, cmp func(x, y T) int) {
 	f := func(x, y T) bool {
-		return x > y
+		return cmp(x, y) > 0
+	}
+	stableSort(s, f)
+}
+
+// Sort slice by given three-way comparator function (such as an ordering.Comparator); the sort is stable.
+// If the input is nil, it will return nil; otherwise, it will return a new sorted slice (the input slice remains unchanged).
+// The comparator function cmp(x,y) must return a negative number if x is smaller than y, a positive number
+// if x is larger than y, and zero in case of equality.
+func SortFunc[T any](s []T, cmp func(x, y T) int) (r []T) {
+	if s == nil {
+		return
+	}
+	r = make([]T, len(s))
+	copy(r, s)
+	SortStableFunc(r, cmp)
+	return
+}
+
+// Sort slice in place by given three-way comparator function (using pattern-defeating quicksort; the sort is not stable).
+// The comparator function cmp(x,y) must return a negative number if x is smaller than y, a positive number
+// if x is larger than y, and zero in case of equality.
+func SortInPlaceFunc[T any](s []T, cmp func(x, y T) int) {
+	stdslices.SortFunc(s, cmp)
+}
+
+// Return minimal element of slice, according to given three-way comparator function;
+// if there are multiple minimal elements, the first one is returned.
+// If the input is nil or empty, the zero value and false will be returned.
+func MinFunc[T any](s []T, cmp func(x, y T) int) (r T, ok bool) {
+	for i, x := range s {
+		if i == 0 || cmp(x, r) < 0 {
+			r = x
+		}
+	}
+	return r, len(s) > 0
+}
+
+// Return maximal element of slice, according to given three-way comparator function;
+// if there are multiple maximal elements, the first one is returned.
+// If the input is nil or empty, the zero value and false will be returned.
+func MaxFunc[T any](s []T, cmp func(x, y T) int) (r T, ok bool) {
+	for i, x := range s {
+		if i == 0 || cmp(x, r) > 0 {
+			r = x
+		}
 	}
-	return SortBy(s, f)
+	return r, len(s) > 0
 }
 
 // Compare two slices by a given equality function.
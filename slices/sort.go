@@ -0,0 +1,67 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices
+
+// Size of the blocks which are sorted by insertion sort, before merging.
+const insertionSortBlockSize = 20
+
+// Stable in-place sort (bottom-up merge sort), using a single auxiliary buffer of the same length as s.
+// The function greater(x,y) must return true if x is larger than y, and false otherwise.
+func stableSort[T any](s []T, greater func(x, y T) bool) {
+	n := len(s)
+	for lo := 0; lo < n; lo += insertionSortBlockSize {
+		insertionSort(s[lo:min(lo+insertionSortBlockSize, n)], greater)
+	}
+	if n <= insertionSortBlockSize {
+		return
+	}
+	src, dst := s, make([]T, n)
+	for width := insertionSortBlockSize; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			mid := min(lo+width, n)
+			hi := min(lo+2*width, n)
+			merge(dst[lo:hi], src[lo:mid], src[mid:hi], greater)
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
+
+// Stable insertion sort.
+func insertionSort[T any](s []T, greater func(x, y T) bool) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && greater(s[j-1], s[j]); j-- {
+			s[j-1], s[j] = s[j], s[j-1]
+		}
+	}
+}
+
+// Stable merge of the sorted slices s1 and s2 into r (which must have length len(s1)+len(s2)).
+func merge[T any](r []T, s1 []T, s2 []T, greater func(x, y T) bool) {
+	if len(s1) == 0 || len(s2) == 0 || !greater(s1[len(s1)-1], s2[0]) {
+		// already in order
+		copy(r[copy(r, s1):], s2)
+		return
+	}
+	i1, i2 := 0, 0
+	for j := range r {
+		if i1 >= len(s1) {
+			r[j] = s2[i2]
+			i2++
+		} else if i2 >= len(s2) {
+			r[j] = s1[i1]
+			i1++
+		} else if greater(s1[i1], s2[i2]) {
+			r[j] = s2[i2]
+			i2++
+		} else {
+			r[j] = s1[i1]
+			i1++
+		}
+	}
+}
@@ -0,0 +1,237 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices_test
+
+import (
+	"math/rand"
+	stdslices "slices"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/ordering"
+	"github.com/sap/go-generics/pairs"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("slices (sorting)", func() {
+	// random pairs, where X is the sort key, and Y the original position
+	randomPairs := func(n int, keys int) []pairs.Pair[int, int] {
+		s := make([]pairs.Pair[int, int], n)
+		for i := range s {
+			s[i] = pairs.Pair[int, int]{X: rand.Intn(keys), Y: i}
+		}
+		return s
+	}
+	expectStablySorted := func(s []pairs.Pair[int, int]) {
+		for i := 1; i < len(s); i++ {
+			Expect(s[i-1].X < s[i].X || s[i-1].X == s[i].X && s[i-1].Y < s[i].Y).To(BeTrue())
+		}
+	}
+
+	Describe("tests for SortBy()", func() {
+		Context("with slices of length one", func() {
+			It("should return a new slice", func() {
+				s := []int{1}
+				r := slices.SortBy(s, func(x, y int) bool { return x > y })
+				Expect(r).To(Equal(s))
+				r[0] = 2
+				Expect(s).To(Equal([]int{1}))
+			})
+		})
+		Context("with random slices of various lengths", func() {
+			It("should return a stably sorted slice, and leave the input unchanged", func() {
+				for _, n := range []int{2, 19, 20, 21, 39, 40, 41, 100, 1000, 1234} {
+					s := randomPairs(n, n/3+1)
+					c := stdslices.Clone(s)
+					r := slices.SortBy(s, func(x, y pairs.Pair[int, int]) bool { return x.X > y.X })
+					Expect(s).To(Equal(c))
+					Expect(r).To(HaveLen(n))
+					expectStablySorted(r)
+				}
+			})
+		})
+	})
+
+	Describe("tests for Sort()", func() {
+		Context("with a random slice", func() {
+			It("should return a new sorted slice, and leave the input unchanged", func() {
+				s := rand.Perm(1000)
+				c := stdslices.Clone(s)
+				r := slices.Sort(s)
+				Expect(s).To(Equal(c))
+				Expect(stdslices.IsSorted(r)).To(BeTrue())
+				Expect(r).To(ConsistOf(c))
+			})
+		})
+	})
+
+	Describe("tests for SortInPlace()", func() {
+		Context("with a nil slice", func() {
+			It("should do nothing", func() {
+				var s []int
+				slices.SortInPlace(s)
+				Expect(s).To(BeNil())
+			})
+		})
+		Context("with a random slice", func() {
+			It("should sort the slice in place", func() {
+				s := rand.Perm(1000)
+				c := stdslices.Clone(s)
+				slices.SortInPlace(s)
+				Expect(stdslices.IsSorted(s)).To(BeTrue())
+				Expect(s).To(ConsistOf(c))
+			})
+		})
+	})
+
+	Describe("tests for SortStableFunc()", func() {
+		Context("with a nil slice", func() {
+			It("should do nothing", func() {
+				var s []int
+				slices.SortStableFunc(s, func(x, y int) int { return x - y })
+				Expect(s).To(BeNil())
+			})
+		})
+		Context("with random slices of various lengths", func() {
+			It("should sort the slice stably in place", func() {
+				for _, n := range []int{1, 2, 19, 20, 21, 39, 40, 41, 100, 1000, 1234} {
+					s := randomPairs(n, n/3+1)
+					slices.SortStableFunc(s, func(x, y pairs.Pair[int, int]) int { return x.X - y.X })
+					expectStablySorted(s)
+				}
+			})
+		})
+	})
+
+	Describe("tests for SortFunc()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.SortFunc(nil, ordering.Natural[int])).To(BeNil())
+			})
+		})
+		Context("with random slices of various lengths", func() {
+			It("should return a stably sorted slice, and leave the input unchanged", func() {
+				for _, n := range []int{0, 1, 2, 21, 100, 1234} {
+					s := randomPairs(n, n/3+1)
+					c := stdslices.Clone(s)
+					r := slices.SortFunc(s, ordering.By(func(p pairs.Pair[int, int]) int { return p.X }))
+					Expect(s).To(Equal(c))
+					Expect(r).NotTo(BeNil())
+					expectStablySorted(r)
+				}
+			})
+		})
+		Context("with a reversed comparator", func() {
+			It("should return a slice sorted in descending order", func() {
+				Expect(slices.SortFunc([]string{"b", "C", "a"}, ordering.Reverse(ordering.CaseInsensitive))).To(Equal([]string{"C", "b", "a"}))
+			})
+		})
+	})
+
+	Describe("tests for SortInPlaceFunc()", func() {
+		Context("with a random slice", func() {
+			It("should sort the slice in place", func() {
+				s := rand.Perm(1000)
+				slices.SortInPlaceFunc(s, ordering.Reverse(ordering.Natural[int]))
+				Expect(s[0]).To(Equal(999))
+				Expect(stdslices.IsSortedFunc(s, ordering.Reverse(ordering.Natural[int]))).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("tests for MinFunc() and MaxFunc()", func() {
+		Context("with an empty slice", func() {
+			It("should return the zero value and false", func() {
+				x, ok := slices.MinFunc([]int{}, ordering.Natural[int])
+				Expect(x).To(Equal(0))
+				Expect(ok).To(BeFalse())
+				x, ok = slices.MaxFunc(nil, ordering.Natural[int])
+				Expect(x).To(Equal(0))
+				Expect(ok).To(BeFalse())
+			})
+		})
+		Context("with a slice containing equal elements", func() {
+			It("should return the first minimal or maximal element", func() {
+				s := []pairs.Pair[int, int]{{X: 2, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 1, Y: 3}}
+				c := ordering.By(func(p pairs.Pair[int, int]) int { return p.X })
+				x, ok := slices.MinFunc(s, c)
+				Expect(x).To(Equal(s[1]))
+				Expect(ok).To(BeTrue())
+				x, ok = slices.MaxFunc(s, c)
+				Expect(x).To(Equal(s[0]))
+				Expect(ok).To(BeTrue())
+			})
+		})
+	})
+})
+
+// implementation of SortBy() before it was redesigned, kept for benchmark comparison
+func legacySortBy[T any](s []T, f func(x, y T) bool) (r []T) {
+	l := len(s)
+	if l <= 1 {
+		return s
+	}
+	s1 := legacySortBy(s[0:l/2], f)
+	s2 := legacySortBy(s[l/2:l], f)
+	r = make([]T, l)
+	i1, i2 := 0, 0
+	for j := 0; j < l; j++ {
+		if i1 >= len(s1) {
+			r[j] = s2[i2]
+			i2++
+		} else if i2 >= len(s2) {
+			r[j] = s1[i1]
+			i1++
+		} else {
+			if f(s1[i1], s2[i2]) {
+				r[j] = s2[i2]
+				i2++
+			} else {
+				r[j] = s1[i1]
+				i1++
+			}
+		}
+	}
+	return
+}
+
+func benchmarkSort(b *testing.B, n int, sort func([]int) []int) {
+	s := rand.New(rand.NewSource(1)).Perm(n)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		sort(s)
+	}
+}
+
+func greater(x, y int) bool {
+	return x > y
+}
+
+func BenchmarkLegacySortBy100(b *testing.B) {
+	benchmarkSort(b, 100, func(s []int) []int { return legacySortBy(s, greater) })
+}
+
+func BenchmarkLegacySortBy10000(b *testing.B) {
+	benchmarkSort(b, 10000, func(s []int) []int { return legacySortBy(s, greater) })
+}
+
+func BenchmarkSortBy100(b *testing.B) {
+	benchmarkSort(b, 100, func(s []int) []int { return slices.SortBy(s, greater) })
+}
+
+func BenchmarkSortBy10000(b *testing.B) {
+	benchmarkSort(b, 10000, func(s []int) []int { return slices.SortBy(s, greater) })
+}
+
+func BenchmarkSort100(b *testing.B) {
+	benchmarkSort(b, 100, slices.Sort[int])
+}
+
+func BenchmarkSort10000(b *testing.B) {
+	benchmarkSort(b, 10000, slices.Sort[int])
+}
@@ -0,0 +1,90 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sap/go-generics/pairs"
+)
+
+// Error returned by ZipStrict() if the input slices have different lengths.
+var ErrLengthMismatch = errors.New("slices have different lengths")
+
+// Combine two slices element-wise into a slice of pairs.
+// If the input slices have different lengths, the result is truncated to the length of the shorter one.
+// If both inputs are nil, it will return nil; otherwise, if the result is empty, it will return an empty slice.
+func Zip[S any, T any](s []S, t []T) []pairs.Pair[S, T] {
+	return ZipWith(s, t, pairs.Make[S, T])
+}
+
+// Combine two slices element-wise into a slice of pairs, failing if the input slices have different lengths
+// (the returned error then wraps ErrLengthMismatch, and the returned slice is nil).
+// If both inputs are nil, it will return nil; otherwise, if the result is empty, it will return an empty slice.
+func ZipStrict[S any, T any](s []S, t []T) ([]pairs.Pair[S, T], error) {
+	if len(s) != len(t) {
+		return nil, fmt.Errorf("%w (%d and %d)", ErrLengthMismatch, len(s), len(t))
+	}
+	return Zip(s, t), nil
+}
+
+// Combine two slices element-wise through given function.
+// If the input slices have different lengths, the result is truncated to the length of the shorter one.
+// If both inputs are nil, it will return nil; otherwise, if the result is empty, it will return an empty slice.
+func ZipWith[S any, T any, U any](s []S, t []T, f func(S, T) U) (r []U) {
+	if s == nil && t == nil {
+		return
+	}
+	r = make([]U, min(len(s), len(t)))
+	for i := range r {
+		r[i] = f(s[i], t[i])
+	}
+	return
+}
+
+// Split slice of pairs into a slice of their first, and a slice of their second components.
+// If the input is nil, it will return nil, nil; otherwise, if the input is empty, it will return empty slices.
+func Unzip[S any, T any](p []pairs.Pair[S, T]) (s []S, t []T) {
+	if p == nil {
+		return
+	}
+	s = make([]S, len(p))
+	t = make([]T, len(p))
+	for i, x := range p {
+		s[i], t[i] = x.X, x.Y
+	}
+	return
+}
+
+// Return the cartesian product of two slices, that is, all pairs (x, y) with x from s and y from t,
+// ordered by the index in s first, and then by the index in t.
+// If both inputs are nil, it will return nil; otherwise, if the result is empty, it will return an empty slice.
+func CartesianProduct[S any, T any](s []S, t []T) (r []pairs.Pair[S, T]) {
+	if s == nil && t == nil {
+		return
+	}
+	r = make([]pairs.Pair[S, T], 0, len(s)*len(t))
+	for _, x := range s {
+		for _, y := range t {
+			r = append(r, pairs.Pair[S, T]{X: x, Y: y})
+		}
+	}
+	return
+}
+
+// Return slice of pairs consisting of the index and the value of each element of given slice.
+// If the input is nil, it will return nil; otherwise, if the input is empty, it will return an empty slice.
+func Enumerate[T any](s []T) (r []pairs.Pair[int, T]) {
+	if s == nil {
+		return
+	}
+	r = make([]pairs.Pair[int, T], len(s))
+	for i, x := range s {
+		r[i] = pairs.Pair[int, T]{X: i, Y: x}
+	}
+	return
+}
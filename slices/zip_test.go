@@ -0,0 +1,119 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slices_test
+
+import (
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/pairs"
+	"github.com/sap/go-generics/slices"
+)
+
+var _ = Describe("slices (zipping)", func() {
+	var ints []int
+	var strs []string
+
+	BeforeEach(func() {
+		ints = []int{1, 2, 3}
+		strs = []string{"a", "b"}
+	})
+
+	Describe("tests for Zip()", func() {
+		Context("with nil slices", func() {
+			It("should return nil", func() {
+				Expect(slices.Zip[int, string](nil, nil)).To(BeNil())
+			})
+		})
+		Context("with a nil and a non-empty slice", func() {
+			It("should return an empty slice", func() {
+				Expect(slices.Zip[int](nil, strs)).To(Equal([]pairs.Pair[int, string]{}))
+			})
+		})
+		Context("with slices of different lengths", func() {
+			It("should truncate to the shorter slice", func() {
+				Expect(slices.Zip(ints, strs)).To(Equal([]pairs.Pair[int, string]{{X: 1, Y: "a"}, {X: 2, Y: "b"}}))
+			})
+		})
+	})
+
+	Describe("tests for ZipStrict()", func() {
+		Context("with slices of the same length", func() {
+			It("should return the pairs", func() {
+				r, err := slices.ZipStrict(ints[:2], strs)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r).To(Equal([]pairs.Pair[int, string]{{X: 1, Y: "a"}, {X: 2, Y: "b"}}))
+			})
+		})
+		Context("with slices of different lengths", func() {
+			It("should fail", func() {
+				r, err := slices.ZipStrict(ints, strs)
+				Expect(err).To(MatchError(slices.ErrLengthMismatch))
+				Expect(err).To(MatchError("slices have different lengths (3 and 2)"))
+				Expect(r).To(BeNil())
+			})
+		})
+	})
+
+	Describe("tests for ZipWith()", func() {
+		It("should combine the elements through the given function", func() {
+			f := func(x int, y string) string { return y + strconv.Itoa(x) }
+			Expect(slices.ZipWith(ints, strs, f)).To(Equal([]string{"a1", "b2"}))
+			Expect(slices.ZipWith([]int{}, strs, f)).To(Equal([]string{}))
+		})
+	})
+
+	Describe("tests for Unzip()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil, nil", func() {
+				s, t := slices.Unzip[int, string](nil)
+				Expect(s).To(BeNil())
+				Expect(t).To(BeNil())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should be inverse to Zip()", func() {
+				s, t := slices.Unzip(slices.Zip(ints[:2], strs))
+				Expect(s).To(Equal(ints[:2]))
+				Expect(t).To(Equal(strs))
+			})
+		})
+	})
+
+	Describe("tests for CartesianProduct()", func() {
+		Context("with nil slices", func() {
+			It("should return nil", func() {
+				Expect(slices.CartesianProduct[int, string](nil, nil)).To(BeNil())
+			})
+		})
+		Context("with an empty slice", func() {
+			It("should return an empty slice", func() {
+				Expect(slices.CartesianProduct(ints, []string{})).To(Equal([]pairs.Pair[int, string]{}))
+			})
+		})
+		Context("with non-empty slices", func() {
+			It("should return all pairs", func() {
+				Expect(slices.CartesianProduct(ints, strs)).To(Equal([]pairs.Pair[int, string]{
+					{X: 1, Y: "a"}, {X: 1, Y: "b"}, {X: 2, Y: "a"}, {X: 2, Y: "b"}, {X: 3, Y: "a"}, {X: 3, Y: "b"},
+				}))
+			})
+		})
+	})
+
+	Describe("tests for Enumerate()", func() {
+		Context("with a nil slice", func() {
+			It("should return nil", func() {
+				Expect(slices.Enumerate[string](nil)).To(BeNil())
+			})
+		})
+		Context("with a non-empty slice", func() {
+			It("should return index-value pairs", func() {
+				Expect(slices.Enumerate(strs)).To(Equal([]pairs.Pair[int, string]{{X: 0, Y: "a"}, {X: 1, Y: "b"}}))
+			})
+		})
+	})
+})
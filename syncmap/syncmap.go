@@ -0,0 +1,162 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package syncmap
+
+import (
+	"iter"
+	"sync"
+)
+
+// Typed map, safe for concurrent use; wraps sync.Map.
+// Values are stored boxed, such that all atomic operations work for arbitrary value types, except for CompareAndSwap()
+// and CompareAndDelete(), which panic if the values are not comparable.
+// The zero value is an empty map, ready to use. Maps must not be copied after first use.
+type Map[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Get value for given key; the second return value reports whether the key exists.
+func (m *Map[K, V]) Load(k K) (v V, ok bool) {
+	if p, ok := m.load(k); ok {
+		return *p, true
+	}
+	return
+}
+
+// Set value for given key.
+func (m *Map[K, V]) Store(k K, v V) {
+	m.m.Store(k, &v)
+}
+
+// Get existing value for given key if present; otherwise store and return the given value.
+// The second return value reports whether the value was loaded (true) or stored (false).
+func (m *Map[K, V]) LoadOrStore(k K, v V) (V, bool) {
+	p, loaded := m.m.LoadOrStore(k, &v)
+	return *p.(*V), loaded
+}
+
+// Delete entry for given key, and return the previous value; the second return value reports whether the key existed.
+func (m *Map[K, V]) LoadAndDelete(k K) (v V, loaded bool) {
+	if p, loaded := m.m.LoadAndDelete(k); loaded {
+		return *p.(*V), true
+	}
+	return
+}
+
+// Delete entry for given key.
+func (m *Map[K, V]) Delete(k K) {
+	m.m.Delete(k)
+}
+
+// Set value for given key, and return the previous value; the second return value reports whether the key existed.
+func (m *Map[K, V]) Swap(k K, v V) (previous V, loaded bool) {
+	if p, loaded := m.m.Swap(k, &v); loaded {
+		return *p.(*V), true
+	}
+	return
+}
+
+// Set value for given key to new, if the current value equals old; reports whether the value was swapped.
+// Panics if V is not comparable.
+func (m *Map[K, V]) CompareAndSwap(k K, old V, new V) bool {
+	for {
+		p, ok := m.load(k)
+		if !ok || any(*p) != any(old) {
+			return false
+		}
+		if m.m.CompareAndSwap(k, p, &new) {
+			return true
+		}
+	}
+}
+
+// Delete entry for given key, if the current value equals old; reports whether the entry was deleted.
+// Panics if V is not comparable.
+func (m *Map[K, V]) CompareAndDelete(k K, old V) bool {
+	for {
+		p, ok := m.load(k)
+		if !ok || any(*p) != any(old) {
+			return false
+		}
+		if m.m.CompareAndDelete(k, p) {
+			return true
+		}
+	}
+}
+
+// Atomically update entry for given key by the given function.
+// The function f receives the current value (and whether the key exists), and returns the new value, and whether
+// the entry shall be kept (if false, the entry is deleted). Update returns the new value, and whether the entry exists.
+// The function f may be invoked multiple times (in case of concurrent modifications), so it should not have side effects.
+func (m *Map[K, V]) Update(k K, f func(V, bool) (V, bool)) (V, bool) {
+	for {
+		var v V
+		p, loaded := m.load(k)
+		if loaded {
+			v = *p
+		}
+		w, keep := f(v, loaded)
+		switch {
+		case !loaded && !keep:
+			return w, false
+		case !loaded && keep:
+			if _, loaded := m.m.LoadOrStore(k, &w); !loaded {
+				return w, true
+			}
+		case loaded && !keep:
+			if m.m.CompareAndDelete(k, p) {
+				return w, false
+			}
+		case loaded && keep:
+			if m.m.CompareAndSwap(k, p, &w) {
+				return w, true
+			}
+		}
+	}
+}
+
+// Delete all entries.
+func (m *Map[K, V]) Clear() {
+	m.m.Clear()
+}
+
+// Get number of entries.
+// Note that this iterates over all entries; in presence of concurrent modifications, the result is not exact.
+func (m *Map[K, V]) Len() (n int) {
+	m.m.Range(func(any, any) bool {
+		n++
+		return true
+	})
+	return
+}
+
+// Get all entries as sequence; order is not predictable.
+// As for sync.Map.Range(), the sequence does not correspond to a consistent snapshot, if the map is modified concurrently.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.m.Range(func(k, p any) bool {
+			return yield(k.(K), *p.(*V))
+		})
+	}
+}
+
+// Get snapshot of the entries as ordinary map.
+// Will return an empty non-nil map in case the map is empty.
+func (m *Map[K, V]) Snapshot() map[K]V {
+	r := make(map[K]V)
+	for k, v := range m.All() {
+		r[k] = v
+	}
+	return r
+}
+
+func (m *Map[K, V]) load(k K) (*V, bool) {
+	p, ok := m.m.Load(k)
+	if !ok {
+		return nil, false
+	}
+	return p.(*V), true
+}
@@ -0,0 +1,142 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package syncmap_test
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/syncmap"
+)
+
+func TestSyncmap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Syncmap Suite")
+}
+
+var _ = Describe("syncmap", func() {
+	var m *syncmap.Map[string, int]
+
+	BeforeEach(func() {
+		m = &syncmap.Map[string, int]{}
+	})
+
+	Describe("tests for an empty map", func() {
+		It("should behave like an empty map", func() {
+			_, ok := m.Load("a")
+			Expect(ok).To(BeFalse())
+			_, ok = m.LoadAndDelete("a")
+			Expect(ok).To(BeFalse())
+			Expect(m.CompareAndSwap("a", 0, 1)).To(BeFalse())
+			Expect(m.CompareAndDelete("a", 0)).To(BeFalse())
+			Expect(m.Len()).To(Equal(0))
+			Expect(m.Snapshot()).To(Equal(map[string]int{}))
+		})
+	})
+
+	Describe("tests for Load(), Store(), Swap() and Delete()", func() {
+		It("should maintain the entries", func() {
+			m.Store("a", 1)
+			v, ok := m.Load("a")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(1))
+			v, ok = m.Swap("a", 2)
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(1))
+			_, ok = m.Swap("b", 3)
+			Expect(ok).To(BeFalse())
+			Expect(m.Snapshot()).To(Equal(map[string]int{"a": 2, "b": 3}))
+			m.Delete("a")
+			v, ok = m.LoadAndDelete("b")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(3))
+			Expect(m.Len()).To(Equal(0))
+		})
+	})
+
+	Describe("tests for LoadOrStore()", func() {
+		It("should store only if absent", func() {
+			v, loaded := m.LoadOrStore("a", 1)
+			Expect(loaded).To(BeFalse())
+			Expect(v).To(Equal(1))
+			v, loaded = m.LoadOrStore("a", 2)
+			Expect(loaded).To(BeTrue())
+			Expect(v).To(Equal(1))
+		})
+	})
+
+	Describe("tests for CompareAndSwap() and CompareAndDelete()", func() {
+		It("should only modify matching entries", func() {
+			m.Store("a", 1)
+			Expect(m.CompareAndSwap("a", 2, 3)).To(BeFalse())
+			Expect(m.CompareAndSwap("a", 1, 3)).To(BeTrue())
+			Expect(m.CompareAndDelete("a", 1)).To(BeFalse())
+			Expect(m.CompareAndDelete("a", 3)).To(BeTrue())
+			Expect(m.Len()).To(Equal(0))
+		})
+		It("should panic for non-comparable values", func() {
+			n := &syncmap.Map[string, []int]{}
+			n.Store("a", []int{1})
+			Expect(func() { n.CompareAndSwap("a", []int{1}, nil) }).To(Panic())
+		})
+	})
+
+	Describe("tests for Update()", func() {
+		It("should insert, update and delete entries", func() {
+			inc := func(v int, _ bool) (int, bool) { return v + 1, true }
+			m.Update("a", inc)
+			v, ok := m.Update("a", inc)
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(2))
+			v, ok = m.Update("a", func(v int, loaded bool) (int, bool) {
+				Expect(loaded).To(BeTrue())
+				return v, false
+			})
+			Expect(ok).To(BeFalse())
+			Expect(v).To(Equal(2))
+			_, ok = m.Update("b", func(v int, _ bool) (int, bool) { return v, false })
+			Expect(ok).To(BeFalse())
+			Expect(m.Len()).To(Equal(0))
+		})
+		It("should work for non-comparable values", func() {
+			n := &syncmap.Map[string, []int]{}
+			n.Update("a", func(v []int, _ bool) ([]int, bool) { return append(v, 1), true })
+			n.Update("a", func(v []int, _ bool) ([]int, bool) { return append(v, 2), true })
+			Expect(n.Snapshot()).To(Equal(map[string][]int{"a": {1, 2}}))
+		})
+		It("should be atomic under concurrent use", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 1000; j++ {
+						m.Update("counter", func(v int, _ bool) (int, bool) { return v + 1, true })
+					}
+				}()
+			}
+			wg.Wait()
+			v, _ := m.Load("counter")
+			Expect(v).To(Equal(8000))
+		})
+	})
+
+	Describe("tests for All() and Clear()", func() {
+		It("should iterate and clear", func() {
+			m.Store("a", 1)
+			m.Store("b", 2)
+			keys := make([]string, 0)
+			for k := range m.All() {
+				keys = append(keys, k)
+			}
+			Expect(keys).To(ConsistOf("a", "b"))
+			m.Clear()
+			Expect(m.Len()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,399 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package treemap
+
+import (
+	"iter"
+
+	"github.com/sap/go-generics/slices"
+)
+
+// Sorted map, backed by a balanced (AVL) binary search tree.
+// Always create tree maps with the New() or NewBy() functions, do not use uninitialized tree maps (i.e. tree maps having the zero value).
+type TreeMap[K any, V any] struct {
+	root *node[K, V]
+	cmp  func(x, y K) int
+}
+
+type node[K any, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V]
+	right  *node[K, V]
+	height int
+	size   int
+}
+
+// Create new tree map for orderable keys.
+func New[K slices.Orderable, V any]() *TreeMap[K, V] {
+	f := func(x, y K) bool {
+		return x > y
+	}
+	return NewBy[K, V](f)
+}
+
+// Create new tree map ordering keys by given comparator function.
+// As for slices.SortBy(), the comparator function f(x,y) must return true if x is larger than y, and false otherwise;
+// two keys x and y are considered equal, if neither f(x,y) nor f(y,x) is true.
+func NewBy[K any, V any](f func(x, y K) bool) *TreeMap[K, V] {
+	cmp := func(x, y K) int {
+		if f(x, y) {
+			return 1
+		}
+		if f(y, x) {
+			return -1
+		}
+		return 0
+	}
+	return &TreeMap[K, V]{cmp: cmp}
+}
+
+// Get number of entries.
+func (m *TreeMap[K, V]) Len() int {
+	return m.root.len()
+}
+
+// Check if map contains given key.
+func (m *TreeMap[K, V]) Has(k K) bool {
+	return m.find(k) != nil
+}
+
+// Get value for given key; the second return value reports whether the key exists.
+func (m *TreeMap[K, V]) Get(k K) (V, bool) {
+	if n := m.find(k); n != nil {
+		return n.value, true
+	}
+	var v V
+	return v, false
+}
+
+// Set value for given key.
+// If the key already exists (in the sense of the comparator), its value will be updated (the stored key remains unchanged).
+func (m *TreeMap[K, V]) Set(k K, v V) {
+	m.root = m.insert(m.root, k, v)
+}
+
+// Delete entry for given key; reports whether the key existed.
+func (m *TreeMap[K, V]) Delete(k K) bool {
+	var ok bool
+	m.root, ok = m.remove(m.root, k)
+	return ok
+}
+
+// Get entry with the smallest key; the third return value reports whether the map is non-empty.
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	n := m.root
+	if n == nil {
+		return result[K, V](nil)
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return result(n)
+}
+
+// Get entry with the largest key; the third return value reports whether the map is non-empty.
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	n := m.root
+	if n == nil {
+		return result[K, V](nil)
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return result(n)
+}
+
+// Get entry with the largest key less than or equal to k; the third return value reports whether such an entry exists.
+func (m *TreeMap[K, V]) Floor(k K) (K, V, bool) {
+	return result(m.below(k, true))
+}
+
+// Get entry with the largest key strictly less than k; the third return value reports whether such an entry exists.
+func (m *TreeMap[K, V]) Lower(k K) (K, V, bool) {
+	return result(m.below(k, false))
+}
+
+// Get entry with the smallest key greater than or equal to k; the third return value reports whether such an entry exists.
+func (m *TreeMap[K, V]) Ceiling(k K) (K, V, bool) {
+	return result(m.above(k, true))
+}
+
+// Get entry with the smallest key strictly greater than k; the third return value reports whether such an entry exists.
+func (m *TreeMap[K, V]) Higher(k K) (K, V, bool) {
+	return result(m.above(k, false))
+}
+
+// Get number of keys strictly less than k.
+// If k exists, this is the (zero-based) position of k in the ordered sequence of keys.
+func (m *TreeMap[K, V]) Rank(k K) int {
+	r := 0
+	n := m.root
+	for n != nil {
+		c := m.cmp(k, n.key)
+		if c <= 0 {
+			n = n.left
+		} else {
+			r += n.left.len() + 1
+			n = n.right
+		}
+	}
+	return r
+}
+
+// Get entry at given (zero-based) position in the ordered sequence of keys; the third return value
+// reports whether i is within range.
+func (m *TreeMap[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= m.Len() {
+		return result[K, V](nil)
+	}
+	n := m.root
+	for {
+		l := n.left.len()
+		switch {
+		case i < l:
+			n = n.left
+		case i > l:
+			i -= l + 1
+			n = n.right
+		default:
+			return result(n)
+		}
+	}
+}
+
+// Get keys in ascending order.
+// Will return an empty non-nil slice in case the map is empty.
+func (m *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Get values in ascending order of the according keys.
+// Will return an empty non-nil slice in case the map is empty.
+func (m *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Get all entries as sequence, in ascending order of keys.
+// The map must not be modified while iterating.
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascend(m.root, nil, nil, yield)
+	}
+}
+
+// Get all entries as sequence, in descending order of keys.
+// The map must not be modified while iterating.
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		descend(m.root, yield)
+	}
+}
+
+// Get entries with keys in the half-open interval [from, to) as sequence, in ascending order of keys.
+// The map must not be modified while iterating.
+func (m *TreeMap[K, V]) Range(from K, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascend(m.root, &from, &to, yield)
+	}
+}
+
+func (n *node[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *node[K, V]) depth() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[K, V]) update() {
+	n.height = max(n.left.depth(), n.right.depth()) + 1
+	n.size = n.left.len() + n.right.len() + 1
+}
+
+func (n *node[K, V]) rotateLeft() *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *node[K, V]) rotateRight() *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+func (n *node[K, V]) balance() *node[K, V] {
+	n.update()
+	switch b := n.left.depth() - n.right.depth(); {
+	case b > 1:
+		if n.left.left.depth() < n.left.right.depth() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case b < -1:
+		if n.right.right.depth() < n.right.left.depth() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (m *TreeMap[K, V]) find(k K) *node[K, V] {
+	n := m.root
+	for n != nil {
+		c := m.cmp(k, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (m *TreeMap[K, V]) insert(n *node[K, V], k K, v V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: k, value: v, height: 1, size: 1}
+	}
+	c := m.cmp(k, n.key)
+	switch {
+	case c < 0:
+		n.left = m.insert(n.left, k, v)
+	case c > 0:
+		n.right = m.insert(n.right, k, v)
+	default:
+		n.value = v
+		return n
+	}
+	return n.balance()
+}
+
+func (m *TreeMap[K, V]) remove(n *node[K, V], k K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var ok bool
+	c := m.cmp(k, n.key)
+	switch {
+	case c < 0:
+		n.left, ok = m.remove(n.left, k)
+	case c > 0:
+		n.right, ok = m.remove(n.right, k)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		var s *node[K, V]
+		n.right, s = removeMin(n.right)
+		s.left = n.left
+		s.right = n.right
+		n = s
+		ok = true
+	}
+	return n.balance(), ok
+}
+
+// Remove the smallest node from the subtree rooted at n; returns the new subtree root and the removed node.
+func removeMin[K any, V any](n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var s *node[K, V]
+	n.left, s = removeMin(n.left)
+	return n.balance(), s
+}
+
+func (m *TreeMap[K, V]) below(k K, inclusive bool) (r *node[K, V]) {
+	n := m.root
+	for n != nil {
+		c := m.cmp(n.key, k)
+		if c < 0 || (inclusive && c == 0) {
+			r = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return
+}
+
+func (m *TreeMap[K, V]) above(k K, inclusive bool) (r *node[K, V]) {
+	n := m.root
+	for n != nil {
+		c := m.cmp(n.key, k)
+		if c > 0 || (inclusive && c == 0) {
+			r = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return
+}
+
+// Yield entries of the subtree rooted at n in ascending order, restricted to the (optional) bounds [from, to).
+// Returns false if iteration was stopped by the consumer.
+func (m *TreeMap[K, V]) ascend(n *node[K, V], from *K, to *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveFrom := from == nil || m.cmp(n.key, *from) >= 0
+	belowTo := to == nil || m.cmp(n.key, *to) < 0
+	if aboveFrom && !m.ascend(n.left, from, to, yield) {
+		return false
+	}
+	if aboveFrom && belowTo && !yield(n.key, n.value) {
+		return false
+	}
+	if belowTo {
+		return m.ascend(n.right, from, to, yield)
+	}
+	return true
+}
+
+// Yield entries of the subtree rooted at n in descending order.
+// Returns false if iteration was stopped by the consumer.
+func descend[K any, V any](n *node[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return descend(n.right, yield) && yield(n.key, n.value) && descend(n.left, yield)
+}
+
+func result[K any, V any](n *node[K, V]) (k K, v V, ok bool) {
+	if n == nil {
+		return
+	}
+	return n.key, n.value, true
+}
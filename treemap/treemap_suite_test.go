@@ -0,0 +1,195 @@
+/*
+SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and go-generics contributors
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package treemap_test
+
+import (
+	"iter"
+	"math/rand"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sap/go-generics/slices"
+	"github.com/sap/go-generics/treemap"
+)
+
+func TestTreemap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Treemap Suite")
+}
+
+func keys[K any, V any](seq iter.Seq2[K, V]) []K {
+	r := make([]K, 0)
+	for k := range seq {
+		r = append(r, k)
+	}
+	return r
+}
+
+var _ = Describe("treemap", func() {
+	var emptyMap *treemap.TreeMap[int, string]
+	var mapA *treemap.TreeMap[int, string]
+
+	BeforeEach(func() {
+		emptyMap = treemap.New[int, string]()
+		mapA = treemap.New[int, string]()
+		for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+			mapA.Set(k, strings.Repeat("x", k/10))
+		}
+	})
+
+	Describe("tests for an empty map", func() {
+		It("should behave like an empty map", func() {
+			Expect(emptyMap.Len()).To(Equal(0))
+			Expect(emptyMap.Has(1)).To(BeFalse())
+			Expect(emptyMap.Delete(1)).To(BeFalse())
+			_, _, ok := emptyMap.Min()
+			Expect(ok).To(BeFalse())
+			_, _, ok = emptyMap.Max()
+			Expect(ok).To(BeFalse())
+			_, _, ok = emptyMap.Floor(1)
+			Expect(ok).To(BeFalse())
+			_, _, ok = emptyMap.Select(0)
+			Expect(ok).To(BeFalse())
+			Expect(emptyMap.Rank(1)).To(Equal(0))
+			Expect(emptyMap.Keys()).To(Equal([]int{}))
+			Expect(emptyMap.Values()).To(Equal([]string{}))
+		})
+	})
+
+	Describe("tests for Get(), Set() and Delete()", func() {
+		It("should maintain the entries", func() {
+			v, ok := mapA.Get(30)
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal("xxx"))
+			mapA.Set(30, "y")
+			v, _ = mapA.Get(30)
+			Expect(v).To(Equal("y"))
+			Expect(mapA.Len()).To(Equal(7))
+			Expect(mapA.Delete(50)).To(BeTrue())
+			Expect(mapA.Delete(50)).To(BeFalse())
+			Expect(mapA.Has(50)).To(BeFalse())
+			Expect(mapA.Keys()).To(Equal([]int{10, 20, 30, 70, 80, 90}))
+		})
+	})
+
+	Describe("tests for Min() and Max()", func() {
+		It("should return the smallest and largest entries", func() {
+			k, v, ok := mapA.Min()
+			Expect(ok).To(BeTrue())
+			Expect(k).To(Equal(10))
+			Expect(v).To(Equal("x"))
+			k, _, _ = mapA.Max()
+			Expect(k).To(Equal(90))
+		})
+	})
+
+	Describe("tests for Floor(), Lower(), Ceiling() and Higher()", func() {
+		It("should return the nearest entries", func() {
+			check := func(f func(int) (int, string, bool), k int, expected int, expectedOk bool) {
+				r, _, ok := f(k)
+				Expect(ok).To(Equal(expectedOk))
+				if ok {
+					Expect(r).To(Equal(expected))
+				}
+			}
+			check(mapA.Floor, 30, 30, true)
+			check(mapA.Floor, 35, 30, true)
+			check(mapA.Floor, 5, 0, false)
+			check(mapA.Lower, 30, 20, true)
+			check(mapA.Lower, 10, 0, false)
+			check(mapA.Ceiling, 30, 30, true)
+			check(mapA.Ceiling, 35, 50, true)
+			check(mapA.Ceiling, 95, 0, false)
+			check(mapA.Higher, 30, 50, true)
+			check(mapA.Higher, 90, 0, false)
+		})
+	})
+
+	Describe("tests for Rank() and Select()", func() {
+		It("should return positions and entries by position", func() {
+			Expect(mapA.Rank(10)).To(Equal(0))
+			Expect(mapA.Rank(50)).To(Equal(3))
+			Expect(mapA.Rank(55)).To(Equal(4))
+			Expect(mapA.Rank(100)).To(Equal(7))
+			for i, k := range mapA.Keys() {
+				r, _, ok := mapA.Select(i)
+				Expect(ok).To(BeTrue())
+				Expect(r).To(Equal(k))
+			}
+			_, _, ok := mapA.Select(7)
+			Expect(ok).To(BeFalse())
+			_, _, ok = mapA.Select(-1)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("tests for All(), Backward() and Range()", func() {
+		It("should yield the entries in order", func() {
+			Expect(keys(mapA.All())).To(Equal([]int{10, 20, 30, 50, 70, 80, 90}))
+			Expect(keys(mapA.Backward())).To(Equal([]int{90, 80, 70, 50, 30, 20, 10}))
+			Expect(keys(mapA.Range(20, 80))).To(Equal([]int{20, 30, 50, 70}))
+			Expect(keys(mapA.Range(21, 79))).To(Equal([]int{30, 50, 70}))
+			Expect(keys(mapA.Range(80, 20))).To(BeEmpty())
+		})
+		It("should stop early", func() {
+			var r []int
+			for k := range mapA.Range(0, 100) {
+				if k > 30 {
+					break
+				}
+				r = append(r, k)
+			}
+			Expect(r).To(Equal([]int{10, 20, 30}))
+		})
+	})
+
+	Describe("tests for NewBy()", func() {
+		It("should order keys by the comparator function", func() {
+			// case-insensitive, descending
+			m := treemap.NewBy[string, int](func(x, y string) bool { return strings.ToLower(x) < strings.ToLower(y) })
+			m.Set("b", 1)
+			m.Set("A", 2)
+			m.Set("c", 3)
+			m.Set("a", 4)
+			Expect(m.Keys()).To(Equal([]string{"c", "b", "A"}))
+			v, _ := m.Get("A")
+			Expect(v).To(Equal(4))
+		})
+	})
+
+	Describe("tests with random operations", func() {
+		It("should behave like a sorted slice", func() {
+			r := rand.New(rand.NewSource(1))
+			m := treemap.New[int, int]()
+			ref := make(map[int]int)
+			for i := 0; i < 5000; i++ {
+				k := r.Intn(500)
+				if r.Intn(3) == 0 {
+					_, ok := ref[k]
+					Expect(m.Delete(k)).To(Equal(ok))
+					delete(ref, k)
+				} else {
+					m.Set(k, i)
+					ref[k] = i
+				}
+			}
+			sorted := make([]int, 0)
+			for k := range ref {
+				sorted = append(sorted, k)
+			}
+			sorted = slices.Sort(sorted)
+			Expect(m.Len()).To(Equal(len(ref)))
+			Expect(m.Keys()).To(Equal(sorted))
+			for i, k := range sorted {
+				Expect(m.Rank(k)).To(Equal(i))
+				v, _ := m.Get(k)
+				Expect(v).To(Equal(ref[k]))
+			}
+		})
+	})
+})